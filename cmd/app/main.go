@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -21,12 +22,25 @@ func main() {
 
 	log := setupLogger(cfg.Env)
 
+	// Arguments left after the flags select a command, see app.RunCommand.
+	if args := flag.Args(); len(args) > 0 {
+		if err := app.RunCommand(log, cfg, args); err != nil {
+			log.Error(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	application := app.Run(log, cfg)
 
 	go func() {
 		application.GRPCServer.Run()
 	}()
 
+	go application.MetricsServer.MustRun()
+
+	go application.PurgeJob.Run()
+
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
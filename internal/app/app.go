@@ -1,20 +1,32 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 
 	grpcapp "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/app/grpc"
+	metricsapp "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/app/metrics"
+	purgeapp "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/app/purge"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/config"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/repositories/cached"
 	repositories "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/repositories/postgresql"
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/cache"
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
 )
 
 type App struct {
-	db         *postgres.Postgres
-	GRPCServer *grpcapp.App
+	db            *postgres.Postgres
+	redis         *redis.Client
+	GRPCServer    *grpcapp.App
+	MetricsServer *metricsapp.App
+	PurgeJob      *purgeapp.App
 }
 
 func Run(
@@ -28,22 +40,90 @@ func Run(
 		os.Exit(1)
 	}
 
+	// Auth
+	keys, err := loadKeys(cfg.Auth)
+	if err != nil {
+		slog.Error(fmt.Errorf("app - Run - loadKeys: %w", err).Error())
+		os.Exit(1)
+	}
+	verifier := auth.NewVerifier(keys, cfg.Auth.Issuer, cfg.Auth.Audience)
+
+	// Cache
+	store, rdb, err := newCacheStore(cfg.Cache)
+	if err != nil {
+		slog.Error(fmt.Errorf("app - Run - newCacheStore: %w", err).Error())
+		os.Exit(1)
+	}
+	registry := prometheus.NewRegistry()
+	metrics := cache.NewMetrics(registry)
+
 	// Repository
 	crsRepo := repositories.NewCourseRepository(pg)
+	enrRepo := repositories.NewEnrollmentRepository(pg)
+	cachedRepo := cached.NewCourseRepository(crsRepo, metrics.Instrument(store, "courses"), cfg.Cache.TTL)
 
 	// Services
-	course := services.NewCourseService(log, crsRepo)
+	course := services.NewCourseService(log, cachedRepo, cachedRepo)
+	enrollment := services.NewEnrollmentService(log, enrRepo, crsRepo)
+
+	// Lessons saved before markdown was rendered on write. Failures are
+	// logged by the service and retried on the next start.
+	_, _ = course.RenderLegacyLessons(context.Background())
 
 	// GRPC
-	gRPCServer := grpcapp.New(log, course, cfg.GRPC.Port)
+	gRPCServer := grpcapp.New(log, course, enrollment, verifier, cfg.GRPC.Port)
+
+	// Metrics
+	metricsServer := metricsapp.New(log, registry, cfg.Metrics.Port)
+
+	// Background jobs
+	purgeJob := purgeapp.New(log, course, cfg.Purge.Interval, cfg.Purge.Retention)
 
 	return &App{
-		db:         pg,
-		GRPCServer: gRPCServer,
+		db:            pg,
+		redis:         rdb,
+		GRPCServer:    gRPCServer,
+		MetricsServer: metricsServer,
+		PurgeJob:      purgeJob,
+	}
+}
+
+// newCacheStore builds the store selected by cfg.Driver. The redis client is
+// returned as well so that it can be closed on shutdown.
+func newCacheStore(cfg config.CacheConfig) (cache.Store, *redis.Client, error) {
+	switch cfg.Driver {
+	case "lru":
+		store, err := cache.NewLRU(cfg.Size)
+		return store, nil, err
+	case "redis":
+		opts, err := redis.ParseURL(cfg.RedisURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		rdb := redis.NewClient(opts)
+		return cache.NewRedis(rdb), rdb, nil
 	}
+	return nil, nil, fmt.Errorf("unknown cache driver %q", cfg.Driver)
+}
+
+func loadKeys(cfg config.AuthConfig) (*auth.KeySet, error) {
+	switch {
+	case cfg.JWKSPath != "":
+		return auth.LoadJWKS(cfg.JWKSPath)
+	case cfg.PublicKeyPath != "":
+		return auth.LoadPEMKey(cfg.PublicKeyPath)
+	case cfg.Secret != "":
+		return auth.StaticSecret(cfg.Secret), nil
+	}
+	return nil, errors.New("one of auth.jwks_path, auth.public_key_path or auth.secret is required")
 }
 
 func (s *App) Shutdown() {
 	defer s.db.Close()
+	if s.redis != nil {
+		defer s.redis.Close()
+	}
+	defer s.MetricsServer.Stop()
+	defer s.PurgeJob.Stop()
 	defer s.GRPCServer.Stop()
 }
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/archive"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/config"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/repositories/cached"
+	repositories "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/repositories/postgresql"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+)
+
+const commandUsage = `usage:
+  app [--config=path] export <course id> <file>
+  app [--config=path] import <file>
+
+The archive format follows the file extension: YAML for .yaml and .yml,
+JSON otherwise.`
+
+// RunCommand runs one of the subcommands of cmd/app instead of the server.
+// Commands act as an admin, so they may export and update any course.
+func RunCommand(log *slog.Logger, cfg *config.Config, args []string) error {
+	var run func(ctx context.Context, course *services.CourseService) error
+	switch {
+	case len(args) == 3 && args[0] == "export":
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid course id %q\n\n%s", args[1], commandUsage)
+		}
+		run = func(ctx context.Context, course *services.CourseService) error {
+			return exportCourse(ctx, course, id, args[2])
+		}
+	case len(args) == 2 && args[0] == "import":
+		run = func(ctx context.Context, course *services.CourseService) error {
+			return importCourse(ctx, log, course, args[1])
+		}
+	default:
+		return errors.New(commandUsage)
+	}
+
+	pg, err := postgres.New(cfg.Database.URL, postgres.MaxPoolSize(cfg.Database.PoolMax))
+	if err != nil {
+		return fmt.Errorf("app - RunCommand - postgres.New: %w", err)
+	}
+	defer pg.Close()
+
+	// The cache is shared with the servers when it lives in redis, so the
+	// commands have to invalidate it as well.
+	store, rdb, err := newCacheStore(cfg.Cache)
+	if err != nil {
+		return fmt.Errorf("app - RunCommand - newCacheStore: %w", err)
+	}
+	if rdb != nil {
+		defer rdb.Close()
+	}
+
+	crsRepo := cached.NewCourseRepository(repositories.NewCourseRepository(pg), store, cfg.Cache.TTL)
+	course := services.NewCourseService(log, crsRepo, crsRepo)
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Roles: []auth.Role{auth.RoleAdmin}})
+	return describe(run(ctx, course))
+}
+
+func exportCourse(ctx context.Context, course *services.CourseService, id int, path string) error {
+	obj, err := course.ExportCourse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	data, err := archive.Marshal(obj, archive.FormatOf(path))
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func importCourse(ctx context.Context, log *slog.Logger, course *services.CourseService, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	obj, err := archive.Unmarshal(data, archive.FormatOf(path))
+	if err != nil {
+		return err
+	}
+
+	id, created, err := course.ImportCourse(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	log.Info("course imported", slog.Int("id", id), slog.Bool("created", created))
+	return nil
+}
+
+// describe adds the field violations of a domain error to its message,
+// which is all a command can show.
+func describe(err error) error {
+	var domainErr *entities.Error
+	if !errors.As(err, &domainErr) || len(domainErr.Violations) == 0 {
+		return err
+	}
+
+	for _, v := range domainErr.Violations {
+		err = fmt.Errorf("%w\n  %s: %s", err, v.Field, v.Description)
+	}
+	return err
+}
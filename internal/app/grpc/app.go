@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net"
 
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
 	coursegrpc "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/controller"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
@@ -23,6 +24,8 @@ type App struct {
 func New(
 	log *slog.Logger,
 	courseService coursegrpc.Course,
+	enrollmentService coursegrpc.Enrollment,
+	verifier *auth.Verifier,
 	port int,
 ) *App {
 	loggingOpts := []logging.Option{
@@ -39,12 +42,22 @@ func New(
 		}),
 	}
 
-	gRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
-		recovery.UnaryServerInterceptor(recoveryOpts...),
-		logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
-	))
+	gRPCServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			recovery.UnaryServerInterceptor(recoveryOpts...),
+			logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
+			coursegrpc.AuthUnaryInterceptor(verifier),
+			coursegrpc.ValidationUnaryInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(recoveryOpts...),
+			logging.StreamServerInterceptor(InterceptorLogger(log), loggingOpts...),
+			coursegrpc.AuthStreamInterceptor(verifier),
+			coursegrpc.ValidationStreamInterceptor(),
+		),
+	)
 
-	coursegrpc.Register(gRPCServer, courseService)
+	coursegrpc.Register(gRPCServer, courseService, enrollmentService)
 
 	return &App{
 		log:        log,
@@ -0,0 +1,80 @@
+package purgeapp
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Purger interface {
+	PurgeDeletedCourses(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// App periodically hard-deletes courses whose soft-delete retention period
+// has run out.
+type App struct {
+	log       *slog.Logger
+	purger    Purger
+	interval  time.Duration
+	retention time.Duration
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func New(
+	log *slog.Logger,
+	purger Purger,
+	interval time.Duration,
+	retention time.Duration,
+) *App {
+	return &App{
+		log:       log,
+		purger:    purger,
+		interval:  interval,
+		retention: retention,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Run purges once right away and then on every tick until Stop is called.
+func (a *App) Run() {
+	const op = "purgeapp.Run"
+
+	defer close(a.done)
+
+	a.log.Info("purge job started",
+		slog.String("op", op),
+		slog.Duration("interval", a.interval),
+		slog.Duration("retention", a.retention))
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		a.purge()
+
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) purge() {
+	ctx, cancel := context.WithTimeout(context.Background(), a.interval)
+	defer cancel()
+
+	// Errors are logged by the service; the next tick retries.
+	_, _ = a.purger.PurgeDeletedCourses(ctx, a.retention)
+}
+
+func (a *App) Stop() {
+	const op = "purgeapp.Stop"
+
+	a.log.With(slog.String("op", op)).Info("stopping purge job")
+
+	close(a.stop)
+	<-a.done
+}
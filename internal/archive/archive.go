@@ -0,0 +1,414 @@
+// Package archive converts courses to and from their portable form: a
+// versioned JSON or YAML document holding the course with its themes and
+// lessons. Every element carries its external key, so importing the same
+// archive twice updates the course instead of creating a copy.
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the version of the documents written by Marshal. Unmarshal
+// rejects any other version.
+const Version = 1
+
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+var (
+	ErrInvalidArchive = entities.NewError(entities.KindInvalidArgument, "ARCHIVE_INVALID", "invalid course archive")
+	ErrVersion        = entities.NewError(entities.KindInvalidArgument, "ARCHIVE_VERSION_UNSUPPORTED", "unsupported course archive version")
+	ErrFormat         = entities.NewError(entities.KindInvalidArgument, "ARCHIVE_FORMAT_UNSUPPORTED", "unsupported course archive format")
+)
+
+// FormatOf picks the format from the extension of path: YAML for .yaml and
+// .yml, JSON otherwise.
+func FormatOf(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return YAML
+	}
+	return JSON
+}
+
+type document struct {
+	Version int    `json:"version" yaml:"version"`
+	Course  course `json:"course" yaml:"course"`
+}
+
+type course struct {
+	Key              string  `json:"key" yaml:"key"`
+	Title            string  `json:"title" yaml:"title"`
+	Description      string  `json:"description" yaml:"description"`
+	FullDescription  string  `json:"full_description,omitempty" yaml:"full_description,omitempty"`
+	Work             string  `json:"work,omitempty" yaml:"work,omitempty"`
+	Difficulty       string  `json:"difficulty" yaml:"difficulty"`
+	DurationOverride *int32  `json:"duration_override,omitempty" yaml:"duration_override,omitempty"`
+	Image            string  `json:"image" yaml:"image"`
+	Themes           []theme `json:"themes" yaml:"themes"`
+}
+
+type theme struct {
+	Key              string   `json:"key" yaml:"key"`
+	Title            string   `json:"title" yaml:"title"`
+	DurationOverride *int32   `json:"duration_override,omitempty" yaml:"duration_override,omitempty"`
+	Lessons          []lesson `json:"lessons" yaml:"lessons"`
+}
+
+// lesson holds exactly one of the bodies, like the oneof of the proto
+// definition.
+type lesson struct {
+	Key        string          `json:"key" yaml:"key"`
+	Title      string          `json:"title" yaml:"title"`
+	Duration   int32           `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Task       string          `json:"task,omitempty" yaml:"task,omitempty"`
+	TaskSpec   *taskSpec       `json:"task_spec,omitempty" yaml:"task_spec,omitempty"`
+	Text       *textBody       `json:"text,omitempty" yaml:"text,omitempty"`
+	Video      *videoBody      `json:"video,omitempty" yaml:"video,omitempty"`
+	Quiz       *quizBody       `json:"quiz,omitempty" yaml:"quiz,omitempty"`
+	Assignment *assignmentBody `json:"assignment,omitempty" yaml:"assignment,omitempty"`
+	Link       *linkBody       `json:"link,omitempty" yaml:"link,omitempty"`
+}
+
+// The rendered HTML and table of contents of text lessons are left out;
+// they are rendered again on import.
+type textBody struct {
+	Markdown string `json:"markdown" yaml:"markdown"`
+}
+
+type videoBody struct {
+	URL             string `json:"url" yaml:"url"`
+	DurationSeconds int32  `json:"duration_seconds,omitempty" yaml:"duration_seconds,omitempty"`
+}
+
+type quizBody struct {
+	Instructions string `json:"instructions" yaml:"instructions"`
+}
+
+type assignmentBody struct {
+	Instructions string `json:"instructions" yaml:"instructions"`
+	DueDays      int32  `json:"due_days,omitempty" yaml:"due_days,omitempty"`
+}
+
+type linkBody struct {
+	URL   string `json:"url" yaml:"url"`
+	Label string `json:"label,omitempty" yaml:"label,omitempty"`
+}
+
+// taskSpec is the typed task of a lesson. It holds exactly one of the
+// bodies; the choice body serves both choice kinds.
+type taskSpec struct {
+	Kind     string        `json:"kind" yaml:"kind"`
+	MaxScore int           `json:"max_score,omitempty" yaml:"max_score,omitempty"`
+	Choice   *choiceTask   `json:"choice,omitempty" yaml:"choice,omitempty"`
+	FreeText *freeTextTask `json:"free_text,omitempty" yaml:"free_text,omitempty"`
+	Code     *codeTask     `json:"code,omitempty" yaml:"code,omitempty"`
+}
+
+type choiceTask struct {
+	Question string   `json:"question" yaml:"question"`
+	Options  []string `json:"options" yaml:"options"`
+	Correct  []int    `json:"correct" yaml:"correct"`
+}
+
+type freeTextTask struct {
+	Question        string   `json:"question" yaml:"question"`
+	AcceptedAnswers []string `json:"accepted_answers,omitempty" yaml:"accepted_answers,omitempty"`
+	CaseSensitive   bool     `json:"case_sensitive,omitempty" yaml:"case_sensitive,omitempty"`
+}
+
+type codeTask struct {
+	Statement      string `json:"statement" yaml:"statement"`
+	Language       string `json:"language" yaml:"language"`
+	ExpectedOutput string `json:"expected_output" yaml:"expected_output"`
+}
+
+// Marshal writes the course with its themes and lessons as an archive.
+func Marshal(obj *entities.Course, format Format) ([]byte, error) {
+	doc := document{
+		Version: Version,
+		Course:  fromCourse(obj),
+	}
+
+	switch format {
+	case JSON:
+		return json.MarshalIndent(doc, "", "  ")
+	case YAML:
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return nil, ErrFormat
+}
+
+// Unmarshal reads a course from an archive. Unknown fields, missing or
+// repeated keys and lessons without exactly one body are rejected; the
+// content itself, typed tasks included, is validated when the course is
+// imported.
+func Unmarshal(data []byte, format Format) (*entities.Course, error) {
+	var doc document
+
+	var err error
+	switch format {
+	case JSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&doc)
+	case YAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(&doc)
+	default:
+		return nil, ErrFormat
+	}
+	if err != nil {
+		return nil, ErrInvalidArchive.WithField("data", err.Error())
+	}
+
+	if doc.Version != Version {
+		return nil, ErrVersion.WithMetadata("version", fmt.Sprint(doc.Version))
+	}
+
+	if err := checkKeys(&doc.Course); err != nil {
+		return nil, err
+	}
+
+	return toCourse(&doc.Course)
+}
+
+// checkKeys makes sure every element has a key and that theme and lesson
+// keys are unique within the course, as they are in the database.
+func checkKeys(c *course) error {
+	if c.Key == "" {
+		return ErrInvalidArchive.WithField("course.key", "is required")
+	}
+
+	themes := make(map[string]bool, len(c.Themes))
+	lessons := make(map[string]bool)
+	for i, th := range c.Themes {
+		field := fmt.Sprintf("course.themes[%d].key", i)
+		switch {
+		case th.Key == "":
+			return ErrInvalidArchive.WithField(field, "is required")
+		case themes[th.Key]:
+			return ErrInvalidArchive.WithField(field, "is repeated")
+		}
+		themes[th.Key] = true
+
+		for j, ls := range th.Lessons {
+			field := fmt.Sprintf("course.themes[%d].lessons[%d].key", i, j)
+			switch {
+			case ls.Key == "":
+				return ErrInvalidArchive.WithField(field, "is required")
+			case lessons[ls.Key]:
+				return ErrInvalidArchive.WithField(field, "is repeated")
+			}
+			lessons[ls.Key] = true
+		}
+	}
+
+	return nil
+}
+
+func fromCourse(obj *entities.Course) course {
+	themes := make([]theme, len(obj.Themes))
+	for i, th := range obj.Themes {
+		lessons := make([]lesson, len(th.Lessons))
+		for j, ls := range th.Lessons {
+			lessons[j] = fromLesson(ls)
+		}
+		themes[i] = theme{
+			Key:              th.ExternalKey,
+			Title:            th.Title,
+			DurationOverride: th.DurationOverride,
+			Lessons:          lessons,
+		}
+	}
+
+	return course{
+		Key:              obj.ExternalKey,
+		Title:            obj.Title,
+		Description:      obj.Description,
+		FullDescription:  obj.FullDescription,
+		Work:             obj.Work,
+		Difficulty:       obj.Difficulty,
+		DurationOverride: obj.DurationOverride,
+		Image:            obj.Image,
+		Themes:           themes,
+	}
+}
+
+func fromLesson(obj *entities.Lesson) lesson {
+	res := lesson{
+		Key:      obj.ExternalKey,
+		Title:    obj.Title,
+		Duration: obj.Duration,
+		Task:     obj.Task,
+		TaskSpec: fromTask(obj.TaskSpec),
+	}
+
+	switch b := obj.Body().(type) {
+	case *entities.TextBody:
+		res.Text = &textBody{Markdown: b.Markdown}
+	case *entities.VideoBody:
+		res.Video = &videoBody{URL: b.URL, DurationSeconds: b.DurationSeconds}
+	case *entities.QuizBody:
+		res.Quiz = &quizBody{Instructions: b.Instructions}
+	case *entities.AssignmentBody:
+		res.Assignment = &assignmentBody{Instructions: b.Instructions, DueDays: b.DueDays}
+	case *entities.LinkBody:
+		res.Link = &linkBody{URL: b.URL, Label: b.Label}
+	}
+
+	return res
+}
+
+func fromTask(obj *entities.Task) *taskSpec {
+	if obj == nil {
+		return nil
+	}
+
+	res := &taskSpec{
+		Kind:     string(obj.Kind),
+		MaxScore: obj.MaxScore,
+	}
+	if obj.Choice != nil {
+		res.Choice = &choiceTask{Question: obj.Choice.Question, Options: obj.Choice.Options, Correct: obj.Choice.Correct}
+	}
+	if obj.FreeText != nil {
+		res.FreeText = &freeTextTask{
+			Question:        obj.FreeText.Question,
+			AcceptedAnswers: obj.FreeText.AcceptedAnswers,
+			CaseSensitive:   obj.FreeText.CaseSensitive,
+		}
+	}
+	if obj.Code != nil {
+		res.Code = &codeTask{Statement: obj.Code.Statement, Language: obj.Code.Language, ExpectedOutput: obj.Code.ExpectedOutput}
+	}
+
+	return res
+}
+
+func toCourse(c *course) (*entities.Course, error) {
+	themes := make([]*entities.Theme, len(c.Themes))
+	for i, th := range c.Themes {
+		lessons := make([]*entities.Lesson, len(th.Lessons))
+		for j, ls := range th.Lessons {
+			body, err := toLessonBody(&ls)
+			if err != nil {
+				return nil, ErrInvalidArchive.WithField(fmt.Sprintf("course.themes[%d].lessons[%d]", i, j), err.Error())
+			}
+
+			task, err := toTask(ls.TaskSpec)
+			if err != nil {
+				return nil, ErrInvalidArchive.WithField(fmt.Sprintf("course.themes[%d].lessons[%d].task_spec", i, j), err.Error())
+			}
+
+			lessons[j] = &entities.Lesson{
+				Title:       ls.Title,
+				Duration:    ls.Duration,
+				Task:        ls.Task,
+				TaskSpec:    task,
+				ExternalKey: ls.Key,
+			}
+			lessons[j].SetBody(body)
+		}
+
+		themes[i] = &entities.Theme{
+			Title:            th.Title,
+			DurationOverride: th.DurationOverride,
+			ExternalKey:      th.Key,
+			Lessons:          lessons,
+		}
+	}
+
+	return &entities.Course{
+		Title:            c.Title,
+		Description:      c.Description,
+		FullDescription:  c.FullDescription,
+		Work:             c.Work,
+		Difficulty:       c.Difficulty,
+		DurationOverride: c.DurationOverride,
+		Image:            c.Image,
+		ExternalKey:      c.Key,
+		Themes:           themes,
+	}, nil
+}
+
+func toLessonBody(ls *lesson) (any, error) {
+	var bodies []any
+	if ls.Text != nil {
+		bodies = append(bodies, &entities.TextBody{Markdown: ls.Text.Markdown})
+	}
+	if ls.Video != nil {
+		bodies = append(bodies, &entities.VideoBody{URL: ls.Video.URL, DurationSeconds: ls.Video.DurationSeconds})
+	}
+	if ls.Quiz != nil {
+		bodies = append(bodies, &entities.QuizBody{Instructions: ls.Quiz.Instructions})
+	}
+	if ls.Assignment != nil {
+		bodies = append(bodies, &entities.AssignmentBody{Instructions: ls.Assignment.Instructions, DueDays: ls.Assignment.DueDays})
+	}
+	if ls.Link != nil {
+		bodies = append(bodies, &entities.LinkBody{URL: ls.Link.URL, Label: ls.Link.Label})
+	}
+
+	if len(bodies) != 1 {
+		return nil, fmt.Errorf("exactly one of text, video, quiz, assignment and link is required")
+	}
+	return bodies[0], nil
+}
+
+// toTask checks that the task has exactly one body. Whether the body
+// matches the kind is left to the validation on import.
+func toTask(t *taskSpec) (*entities.Task, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	res := &entities.Task{
+		Kind:     entities.TaskKind(t.Kind),
+		MaxScore: t.MaxScore,
+	}
+
+	bodies := 0
+	if t.Choice != nil {
+		bodies++
+		res.Choice = &entities.ChoiceTask{Question: t.Choice.Question, Options: t.Choice.Options, Correct: t.Choice.Correct}
+	}
+	if t.FreeText != nil {
+		bodies++
+		res.FreeText = &entities.FreeTextTask{
+			Question:        t.FreeText.Question,
+			AcceptedAnswers: t.FreeText.AcceptedAnswers,
+			CaseSensitive:   t.FreeText.CaseSensitive,
+		}
+	}
+	if t.Code != nil {
+		bodies++
+		res.Code = &entities.CodeTask{Statement: t.Code.Statement, Language: t.Code.Language, ExpectedOutput: t.Code.ExpectedOutput}
+	}
+
+	if bodies != 1 {
+		return nil, fmt.Errorf("exactly one of choice, free_text and code is required")
+	}
+	return res, nil
+}
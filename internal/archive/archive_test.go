@@ -0,0 +1,195 @@
+package archive
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+func TestUnmarshalRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		format Format
+		want   error
+		field  string
+	}{
+		{
+			name:   "unknown format",
+			data:   `{"version": 1}`,
+			format: "xml",
+			want:   ErrFormat,
+		},
+		{
+			name:   "broken json",
+			data:   `{"version": 1,`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "data",
+		},
+		{
+			name:   "broken yaml",
+			data:   "version: [1",
+			format: YAML,
+			want:   ErrInvalidArchive,
+			field:  "data",
+		},
+		{
+			name:   "unknown json field",
+			data:   `{"version": 1, "course": {"key": "c", "owner": 7}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "data",
+		},
+		{
+			name:   "unknown yaml field",
+			data:   "version: 1\ncourse:\n  key: c\n  owner: 7\n",
+			format: YAML,
+			want:   ErrInvalidArchive,
+			field:  "data",
+		},
+		{
+			name:   "missing version",
+			data:   `{"course": {"key": "c"}}`,
+			format: JSON,
+			want:   ErrVersion,
+		},
+		{
+			name:   "newer version",
+			data:   `{"version": 2, "course": {"key": "c"}}`,
+			format: JSON,
+			want:   ErrVersion,
+		},
+		{
+			name:   "missing course key",
+			data:   `{"version": 1, "course": {"title": "c"}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.key",
+		},
+		{
+			name:   "missing theme key",
+			data:   `{"version": 1, "course": {"key": "c", "themes": [{"title": "t"}]}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.themes[0].key",
+		},
+		{
+			name:   "repeated theme key",
+			data:   `{"version": 1, "course": {"key": "c", "themes": [{"key": "t"}, {"key": "t"}]}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.themes[1].key",
+		},
+		{
+			name: "repeated lesson key across themes",
+			data: `{"version": 1, "course": {"key": "c", "themes": [
+				{"key": "t1", "lessons": [{"key": "l", "text": {"markdown": "a"}}]},
+				{"key": "t2", "lessons": [{"key": "l", "text": {"markdown": "b"}}]}]}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.themes[1].lessons[0].key",
+		},
+		{
+			name:   "lesson without body",
+			data:   `{"version": 1, "course": {"key": "c", "themes": [{"key": "t", "lessons": [{"key": "l"}]}]}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.themes[0].lessons[0]",
+		},
+		{
+			name: "lesson with two bodies",
+			data: `{"version": 1, "course": {"key": "c", "themes": [{"key": "t", "lessons": [
+				{"key": "l", "text": {"markdown": "a"}, "link": {"url": "https://example.com"}}]}]}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.themes[0].lessons[0]",
+		},
+		{
+			name: "task with two bodies",
+			data: `{"version": 1, "course": {"key": "c", "themes": [{"key": "t", "lessons": [
+				{"key": "l", "text": {"markdown": "a"}, "task_spec": {"kind": "code",
+					"code": {"statement": "s", "language": "go", "expected_output": "1"},
+					"free_text": {"question": "q"}}}]}]}}`,
+			format: JSON,
+			want:   ErrInvalidArchive,
+			field:  "course.themes[0].lessons[0].task_spec",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Unmarshal([]byte(tt.data), tt.format)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Unmarshal() error = %v, want %v", err, tt.want)
+			}
+			if tt.field == "" {
+				return
+			}
+
+			var e *entities.Error
+			if !errors.As(err, &e) || len(e.Violations) == 0 || e.Violations[0].Field != tt.field {
+				t.Errorf("Unmarshal() error = %v, want a violation of %q", err, tt.field)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	override := int32(90)
+	obj := &entities.Course{
+		Title:       "Go",
+		Description: "Basics",
+		Difficulty:  "easy",
+		Image:       "https://example.com/go.png",
+		ExternalKey: "go",
+		Themes: []*entities.Theme{{
+			Title:            "Intro",
+			DurationOverride: &override,
+			ExternalKey:      "intro",
+			Lessons: []*entities.Lesson{{
+				Title:       "Hello",
+				Duration:    10,
+				ExternalKey: "hello",
+				Kind:        entities.LessonText,
+				Text:        &entities.TextBody{Markdown: "# Hello"},
+				TaskSpec: &entities.Task{
+					Kind:     entities.TaskMultipleChoice,
+					MaxScore: 10,
+					Choice:   &entities.ChoiceTask{Question: "Which?", Options: []string{"a", "b", "c"}, Correct: []int{0, 2}},
+				},
+			}},
+		}},
+	}
+
+	for _, format := range []Format{JSON, YAML} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := Marshal(obj, format)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			got, err := Unmarshal(data, format)
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if got.ExternalKey != "go" || len(got.Themes) != 1 || len(got.Themes[0].Lessons) != 1 {
+				t.Fatalf("Unmarshal() = %+v, want the course back", got)
+			}
+			ls := got.Themes[0].Lessons[0]
+			if ls.ExternalKey != "hello" || ls.Kind != entities.LessonText || ls.Text.Markdown != "# Hello" {
+				t.Errorf("Unmarshal() lesson = %+v, want the text lesson back", ls)
+			}
+			task := ls.TaskSpec
+			if task == nil || task.Kind != entities.TaskMultipleChoice || task.MaxScore != 10 ||
+				task.Choice == nil || len(task.Choice.Options) != 3 || len(task.Choice.Correct) != 2 || task.Choice.Correct[1] != 2 {
+				t.Errorf("Unmarshal() task = %+v, want the choice task back", task)
+			}
+			if *got.Themes[0].DurationOverride != override {
+				t.Errorf("Unmarshal() duration override = %d, want %d", *got.Themes[0].DurationOverride, override)
+			}
+		})
+	}
+}
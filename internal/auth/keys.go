@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+var ErrUnknownKey = errors.New("no key matches the token")
+
+// KeySet holds the keys tokens may be signed with. Keys from a JWKS file are
+// picked by the token's kid header; a static key is used for every token.
+type KeySet struct {
+	keys   map[string]any
+	static any
+}
+
+// LoadJWKS reads a JSON Web Key Set from a local file. Asymmetric private
+// keys in the set are reduced to their public part.
+func LoadJWKS(path string) (*KeySet, error) {
+	const op = "auth.LoadJWKS"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(jwks.Keys) == 0 {
+		return nil, fmt.Errorf("%s: key set %s is empty", op, path)
+	}
+
+	ks := &KeySet{keys: make(map[string]any, len(jwks.Keys))}
+	for _, k := range jwks.Keys {
+		if pub := k.Public(); pub.Valid() {
+			k = pub
+		}
+		ks.keys[k.KeyID] = k.Key
+	}
+
+	return ks, nil
+}
+
+// LoadPEMKey reads a PEM encoded public key (RSA, ECDSA or Ed25519) and uses
+// it for every token.
+func LoadPEMKey(path string) (*KeySet, error) {
+	const op = "auth.LoadPEMKey"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: %s does not contain a PEM block", op, path)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &KeySet{static: key}, nil
+}
+
+// StaticSecret uses a shared HMAC secret for every token.
+func StaticSecret(secret string) *KeySet {
+	return &KeySet{static: []byte(secret)}
+}
+
+// Key returns the key for the given kid.
+func (ks *KeySet) Key(kid string) (any, error) {
+	if ks.static != nil {
+		return ks.static, nil
+	}
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
@@ -0,0 +1,62 @@
+// Package auth describes who is calling the service. The transport layer
+// puts a Principal into the request context and the service layer reads it
+// back to make visibility and permission decisions.
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+type Role string
+
+const (
+	RoleStudent Role = "student"
+	RoleAuthor  Role = "author"
+	RoleAdmin   Role = "admin"
+)
+
+type Principal struct {
+	UserID int
+	Roles  []Role
+}
+
+func (p *Principal) HasRole(roles ...Role) bool {
+	if p == nil {
+		return false
+	}
+
+	for _, r := range roles {
+		if slices.Contains(p.Roles, r) {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the caller of the request, or nil for anonymous
+// requests.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// IsStaff reports whether the caller is an author or an admin. Which
+// unpublished courses staff may see depends on their role in each course.
+func IsStaff(ctx context.Context) bool {
+	return FromContext(ctx).HasRole(RoleAuthor, RoleAdmin)
+}
+
+// UserID returns the id of the caller, or 0 for anonymous requests.
+func UserID(ctx context.Context) int {
+	if p := FromContext(ctx); p != nil {
+		return p.UserID
+	}
+	return 0
+}
@@ -12,6 +12,10 @@ type Config struct {
 	Env            string         `yaml:"env" env-default:"local"`
 	Database       DatabaseConfig `yaml:"database"`
 	GRPC           GRPCConfig     `yaml:"GRPC"`
+	Purge          PurgeConfig    `yaml:"purge"`
+	Auth           AuthConfig     `yaml:"auth"`
+	Cache          CacheConfig    `yaml:"cache"`
+	Metrics        MetricsConfig  `yaml:"metrics"`
 	MigrationsPath string
 }
 
@@ -20,6 +24,36 @@ type GRPCConfig struct {
 	Timeout time.Duration `yaml:"timeout" env-required:"true"`
 }
 
+// PurgeConfig controls how long soft-deleted courses are kept before the
+// background job removes them for good.
+type PurgeConfig struct {
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+}
+
+// AuthConfig tells where the keys for verifying access tokens come from.
+// Exactly one of JWKSPath, PublicKeyPath and Secret has to be set.
+type AuthConfig struct {
+	JWKSPath      string `yaml:"jwks_path" env:"AUTH_JWKS_PATH"`
+	PublicKeyPath string `yaml:"public_key_path" env:"AUTH_PUBLIC_KEY_PATH"`
+	Secret        string `yaml:"secret" env:"AUTH_SECRET"`
+	Issuer        string `yaml:"issuer" env:"AUTH_ISSUER"`
+	Audience      string `yaml:"audience" env:"AUTH_AUDIENCE"`
+}
+
+// CacheConfig selects the store of the course cache: "lru" keeps up to Size
+// entries in memory, "redis" uses the server at RedisURL.
+type CacheConfig struct {
+	Driver   string        `yaml:"driver" env:"CACHE_DRIVER" env-default:"lru"`
+	Size     int           `yaml:"size" env-default:"1024"`
+	TTL      time.Duration `yaml:"ttl" env-default:"5m"`
+	RedisURL string        `yaml:"redis_url" env:"CACHE_REDIS_URL"`
+}
+
+type MetricsConfig struct {
+	Port int `yaml:"port" env-default:"9090"`
+}
+
 type DatabaseConfig struct {
 	URL     string `yaml:"url" env:"PG_URL" env-required:"true"`
 	PoolMax int    `yaml:"pool_max" env-required:"true"`
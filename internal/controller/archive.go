@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/archive"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+)
+
+var archiveFormats = map[coursev1.ArchiveFormat]archive.Format{
+	coursev1.ArchiveFormat_ARCHIVE_FORMAT_JSON: archive.JSON,
+	coursev1.ArchiveFormat_ARCHIVE_FORMAT_YAML: archive.YAML,
+}
+
+func (s *serverAPI) ExportCourse(
+	ctx context.Context,
+	in *coursev1.ExportCourseRequest,
+) (*coursev1.CourseArchive, error) {
+	course, err := s.course.ExportCourse(ctx, int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	data, err := archive.Marshal(course, archiveFormats[in.Format])
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.CourseArchive{
+		Format: in.Format,
+		Data:   data,
+	}, nil
+}
+
+func (s *serverAPI) ImportCourse(
+	ctx context.Context,
+	in *coursev1.CourseArchive,
+) (*coursev1.ImportCourseResponse, error) {
+	course, err := archive.Unmarshal(in.Data, archiveFormats[in.Format])
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	id, created, err := s.course.ImportCourse(ctx, course)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.ImportCourseResponse{
+		Id:      int32(id),
+		Created: created,
+	}, nil
+}
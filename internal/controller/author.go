@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var authorRoleDTOs = map[entities.AuthorRole]coursev1.AuthorRole{
+	entities.AuthorOwner:    coursev1.AuthorRole_AUTHOR_ROLE_OWNER,
+	entities.AuthorEditor:   coursev1.AuthorRole_AUTHOR_ROLE_EDITOR,
+	entities.AuthorReviewer: coursev1.AuthorRole_AUTHOR_ROLE_REVIEWER,
+}
+
+var authorRoleEntities = map[coursev1.AuthorRole]entities.AuthorRole{
+	coursev1.AuthorRole_AUTHOR_ROLE_OWNER:    entities.AuthorOwner,
+	coursev1.AuthorRole_AUTHOR_ROLE_EDITOR:   entities.AuthorEditor,
+	coursev1.AuthorRole_AUTHOR_ROLE_REVIEWER: entities.AuthorReviewer,
+}
+
+func toCoAuthorDTO(obj *entities.CoAuthor) *coursev1.CoAuthor {
+	return &coursev1.CoAuthor{
+		CourseId: int32(obj.CourseID),
+		UserId:   int32(obj.UserID),
+		Role:     authorRoleDTOs[obj.Role],
+		AddedAt:  timestamppb.New(obj.AddedAt),
+	}
+}
+
+func (s *serverAPI) AddCoAuthor(
+	ctx context.Context,
+	in *coursev1.AddCoAuthorRequest,
+) (*coursev1.CoAuthor, error) {
+	obj := &entities.CoAuthor{
+		CourseID: int(in.CourseId),
+		UserID:   int(in.UserId),
+		Role:     authorRoleEntities[in.Role],
+	}
+
+	err := s.course.AddCoAuthor(ctx, obj)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toCoAuthorDTO(obj), nil
+}
+
+func (s *serverAPI) RemoveCoAuthor(
+	ctx context.Context,
+	in *coursev1.RemoveCoAuthorRequest,
+) (*coursev1.SuccessResponse, error) {
+	err := s.course.RemoveCoAuthor(ctx, int(in.CourseId), int(in.UserId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ListCoAuthors(
+	ctx context.Context,
+	in *coursev1.ListCoAuthorsRequest,
+) (*coursev1.ListCoAuthorsResponse, error) {
+	authors, err := s.course.ListCoAuthors(ctx, int(in.CourseId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := make([]*coursev1.CoAuthor, len(authors))
+	for i, a := range authors {
+		res[i] = toCoAuthorDTO(a)
+	}
+
+	return &coursev1.ListCoAuthorsResponse{Authors: res}, nil
+}
+
+func (s *serverAPI) ListMyCourses(
+	ctx context.Context,
+	in *emptypb.Empty,
+) (*coursev1.GetResponse, error) {
+	courses, err := s.course.ListMyCourses(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := make([]*coursev1.Course, len(courses))
+	for i, course := range courses {
+		response[i] = toCourseDTO(course)
+	}
+
+	return &coursev1.GetResponse{
+		Courses: response,
+	}, nil
+}
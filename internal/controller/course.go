@@ -2,13 +2,14 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/lmspackage"
 	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -17,7 +18,8 @@ var (
 
 type serverAPI struct {
 	coursev1.UnimplementedCourseServiceServer
-	course Course
+	course     Course
+	enrollment Enrollment
 }
 
 type Course interface {
@@ -25,45 +27,82 @@ type Course interface {
 	CreateTheme(ctx context.Context, obj *entities.Theme) (int, error)
 	CreateLesson(ctx context.Context, obj *entities.Lesson) (int, error)
 	GetAllCourses(ctx context.Context) ([]*entities.Course, error)
-	GetCourse(ctx context.Context, id int) (*entities.Course, error)
-	GetThemes(ctx context.Context, cid int) ([]*entities.Theme, error)
-	GetLessons(ctx context.Context, cid, tid int) ([]*entities.Lesson, error)
+	ListCourses(ctx context.Context, params entities.CourseListParams) (*entities.CoursePage, error)
+	SearchCourses(ctx context.Context, params entities.SearchParams) (*entities.SearchResult, error)
+	GetCourseTree(ctx context.Context, id int) (*entities.Course, error)
+	StreamCourses(ctx context.Context, fn func(*entities.Course) error) error
+	StreamCourseContent(ctx context.Context, id int, fn func(*entities.CourseContent) error) error
 	DeleteCourse(ctx context.Context, cid int) error
-	UpdateCourse(ctx context.Context, obj *entities.Course) (int, error)
-	UpdateTheme(ctx context.Context, obj *entities.Theme) (int, error)
-	UpdateLesson(ctx context.Context, obj *entities.Lesson) (int, error)
+	UpdateCourse(ctx context.Context, obj *entities.Course, opts entities.UpdateOptions) (int, error)
+	UpdateTheme(ctx context.Context, obj *entities.Theme, fields []string) (int, error)
+	UpdateLesson(ctx context.Context, obj *entities.Lesson, fields []string) (int, error)
+	Reorder(ctx context.Context, order *entities.SyllabusOrder) error
+	ExportCourse(ctx context.Context, id int) (*entities.Course, error)
+	ImportCourse(ctx context.Context, obj *entities.Course) (int, bool, error)
+	ImportPackage(ctx context.Context, data []byte, defaults lmspackage.Defaults) (*lmspackage.Package, error)
+	GetTheme(ctx context.Context, id int) (*entities.Theme, error)
+	GetLesson(ctx context.Context, id int) (*entities.Lesson, error)
+	DeleteTheme(ctx context.Context, id int) error
+	DeleteLesson(ctx context.Context, id int) error
+	ChangeStatus(ctx context.Context, cid int, to entities.CourseStatus) (*entities.StatusTransition, error)
+	RestoreCourse(ctx context.Context, cid int) error
+	ListDeletedCourses(ctx context.Context) ([]*entities.Course, error)
+	ListRevisions(ctx context.Context, entity entities.RevisionEntity, id int) ([]*entities.Revision, error)
+	GetRevision(ctx context.Context, entity entities.RevisionEntity, id, version int) (*entities.Revision, error)
+	DiffRevisions(ctx context.Context, entity entities.RevisionEntity, id, from, to int) ([]*entities.FieldDiff, error)
+	RevertToRevision(ctx context.Context, entity entities.RevisionEntity, id, version int) (*entities.Revision, error)
+	AddCoAuthor(ctx context.Context, obj *entities.CoAuthor) error
+	RemoveCoAuthor(ctx context.Context, cid, uid int) error
+	ListCoAuthors(ctx context.Context, cid int) ([]*entities.CoAuthor, error)
+	ListMyCourses(ctx context.Context) ([]*entities.Course, error)
+	SetTask(ctx context.Context, obj *entities.Task) error
+	GetTask(ctx context.Context, lid int) (*entities.Task, error)
 }
 
-func Register(gRPCServer *grpc.Server, course Course) {
-	coursev1.RegisterCourseServiceServer(gRPCServer, &serverAPI{course: course})
+func Register(gRPCServer *grpc.Server, course Course, enrollment Enrollment) {
+	coursev1.RegisterCourseServiceServer(gRPCServer, &serverAPI{course: course, enrollment: enrollment})
 }
 
 func toCourseEntitie(obj *coursev1.CreateRequest) *entities.Course {
+	themes := make([]*entities.Theme, len(obj.Themes))
+	for i, th := range obj.Themes {
+		themes[i] = toThemeEntitie(th)
+	}
+
 	return &entities.Course{
-		Title:           obj.Title,
-		Description:     obj.Description,
-		FullDescription: obj.FullDescription,
-		Work:            obj.Work,
-		Difficulty:      obj.Difficulty,
-		Duration:        obj.Duration,
-		Image:           obj.Image,
+		Title:            obj.Title,
+		Description:      obj.Description,
+		FullDescription:  obj.FullDescription,
+		Work:             obj.Work,
+		Difficulty:       obj.Difficulty,
+		DurationOverride: obj.DurationOverride,
+		Image:            obj.Image,
+		Themes:           themes,
 	}
 }
 
 func toThemeEntitie(obj *coursev1.CreateTheme) *entities.Theme {
+	lessons := make([]*entities.Lesson, len(obj.Lessons))
+	for i, ls := range obj.Lessons {
+		lessons[i] = toLessonEntitie(ls)
+	}
+
 	return &entities.Theme{
-		Title: obj.Title,
+		Title:            obj.Title,
+		DurationOverride: obj.DurationOverride,
+		Lessons:          lessons,
 	}
 }
 
 func toLessonEntitie(obj *coursev1.CreateLesson) *entities.Lesson {
-	return &entities.Lesson{
+	lesson := &entities.Lesson{
 		Title:    obj.Title,
-		Type:     obj.Type,
 		Duration: obj.Duration,
-		Content:  obj.Content,
 		Task:     obj.Task,
 	}
+	setLessonBody(lesson, obj)
+
+	return lesson
 }
 
 func toCourseDTO(obj *entities.Course) *coursev1.Course {
@@ -76,40 +115,50 @@ func toCourseDTO(obj *entities.Course) *coursev1.Course {
 		Difficulty:      obj.Difficulty,
 		Duration:        obj.Duration,
 		Image:           obj.Image,
+		CreatedAt:       timestamppb.New(obj.CreatedAt),
+		Status:          toStatusDTO(obj.Status),
+		DeletedAt:       toTimestampDTO(obj.DeletedAt),
+		OwnerId:         int32(obj.OwnerID),
+	}
+}
+
+func toTimestampDTO(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toCreateResponse(obj *entities.Course) *coursev1.CreateResponse {
+	themes := make([]*coursev1.CreatedTheme, len(obj.Themes))
+	for i, th := range obj.Themes {
+		lessonIDs := make([]int32, len(th.Lessons))
+		for j, ls := range th.Lessons {
+			lessonIDs[j] = int32(ls.ID)
+		}
+		themes[i] = &coursev1.CreatedTheme{
+			Id:        int32(th.ID),
+			LessonIds: lessonIDs,
+		}
+	}
+
+	return &coursev1.CreateResponse{
+		Id:     int32(obj.ID),
+		Themes: themes,
 	}
 }
 
 func (s *serverAPI) Create(
 	ctx context.Context,
 	in *coursev1.CreateRequest,
-) (*coursev1.SuccessResponse, error) {
+) (*coursev1.CreateResponse, error) {
 	course := toCourseEntitie(in)
-	id, err := s.course.Create(ctx, course)
+	_, err := s.course.Create(ctx, course)
 	if err != nil {
-		return nil, status.Error(codes.Internal, ErrInternalServerError)
-	}
-
-	for _, th := range in.Themes {
-		theme := toThemeEntitie(th)
-		theme.CourseID = id
-		themeID, err := s.course.CreateTheme(ctx, theme)
-		if err != nil {
-			return nil, status.Error(codes.Internal, ErrInternalServerError)
-		}
-		for _, ls := range th.Lessons {
-			lesson := toLessonEntitie(ls)
-			lesson.CourseID = id
-			lesson.ThemeID = themeID
-			_, err := s.course.CreateLesson(ctx, lesson)
-			if err != nil {
-				return nil, status.Error(codes.Internal, ErrInternalServerError)
-			}
-		}
+		return nil, toStatusError(err)
 	}
 
-	return &coursev1.SuccessResponse{
-		Success: true,
-	}, nil
+	return toCreateResponse(course), nil
 }
 
 func (s *serverAPI) GetAll(
@@ -118,7 +167,7 @@ func (s *serverAPI) GetAll(
 ) (*coursev1.GetResponse, error) {
 	courses, err := s.course.GetAllCourses(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, ErrInternalServerError)
+		return nil, toStatusError(err)
 	}
 
 	response := make([]*coursev1.Course, len(courses))
@@ -131,63 +180,158 @@ func (s *serverAPI) GetAll(
 	}, nil
 }
 
+var sortFields = map[coursev1.CourseSortField]entities.CourseSortField{
+	coursev1.CourseSortField_COURSE_SORT_FIELD_UNSPECIFIED: entities.SortByCreatedAt,
+	coursev1.CourseSortField_COURSE_SORT_FIELD_CREATED_AT:  entities.SortByCreatedAt,
+	coursev1.CourseSortField_COURSE_SORT_FIELD_TITLE:       entities.SortByTitle,
+	coursev1.CourseSortField_COURSE_SORT_FIELD_DURATION:    entities.SortByDuration,
+}
+
+func (s *serverAPI) ListCourses(
+	ctx context.Context,
+	in *coursev1.ListCoursesRequest,
+) (*coursev1.ListCoursesResponse, error) {
+	page, err := s.course.ListCourses(ctx, entities.CourseListParams{
+		Limit:        int(in.PageSize),
+		Cursor:       in.PageToken,
+		Difficulties: in.Difficulties,
+		MinDuration:  in.GetMinDuration(),
+		MaxDuration:  in.GetMaxDuration(),
+		SortBy:       sortFields[in.SortBy],
+		Desc:         in.Descending,
+		Statuses:     toStatuses(in.Statuses),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := make([]*coursev1.Course, len(page.Courses))
+	for i, course := range page.Courses {
+		response[i] = toCourseDTO(course)
+	}
+
+	return &coursev1.ListCoursesResponse{
+		Courses:       response,
+		NextPageToken: page.NextCursor,
+		TotalCount:    int32(page.Total),
+	}, nil
+}
+
+var matchKinds = map[entities.SearchMatchKind]coursev1.SearchMatchKind{
+	entities.MatchTheme:  coursev1.SearchMatchKind_SEARCH_MATCH_KIND_THEME,
+	entities.MatchLesson: coursev1.SearchMatchKind_SEARCH_MATCH_KIND_LESSON,
+}
+
+func toSearchHitDTO(obj *entities.SearchHit) *coursev1.SearchHit {
+	matches := make([]*coursev1.SearchMatch, len(obj.Matches))
+	for i, m := range obj.Matches {
+		matches[i] = &coursev1.SearchMatch{
+			Kind:    matchKinds[m.Kind],
+			Id:      int32(m.ID),
+			ThemeId: int32(m.ThemeID),
+			Title:   m.Title,
+			Snippet: m.Snippet,
+		}
+	}
+
+	return &coursev1.SearchHit{
+		Course:  toCourseDTO(obj.Course),
+		Rank:    obj.Rank,
+		Snippet: obj.Snippet,
+		Matches: matches,
+	}
+}
+
+func (s *serverAPI) SearchCourses(
+	ctx context.Context,
+	in *coursev1.SearchCoursesRequest,
+) (*coursev1.SearchCoursesResponse, error) {
+	res, err := s.course.SearchCourses(ctx, entities.SearchParams{
+		Query:  in.Query,
+		Limit:  int(in.Limit),
+		Offset: int(in.Offset),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	hits := make([]*coursev1.SearchHit, len(res.Hits))
+	for i, hit := range res.Hits {
+		hits[i] = toSearchHitDTO(hit)
+	}
+
+	return &coursev1.SearchCoursesResponse{
+		Hits:       hits,
+		TotalCount: int32(res.Total),
+	}, nil
+}
+
 func toThemeDTO(obj *entities.Theme, les []*coursev1.Lesson) *coursev1.Theme {
 	return &coursev1.Theme{
-		Id:      int32(obj.ID),
-		Title:   obj.Title,
-		Lessons: les,
+		Id:               int32(obj.ID),
+		Title:            obj.Title,
+		Lessons:          les,
+		Duration:         obj.Duration,
+		ComputedDuration: obj.ComputedDuration,
+		DurationOverride: obj.DurationOverride,
 	}
 }
 
-func toLessonDTO(obj *entities.Lesson) *coursev1.Lesson {
-	return &coursev1.Lesson{
+func toLessonDTO(obj *entities.Lesson, format coursev1.ContentFormat) *coursev1.Lesson {
+	lesson := &coursev1.Lesson{
 		Id:       int32(obj.ID),
 		Title:    obj.Title,
-		Type:     obj.Type,
 		Duration: obj.Duration,
-		Content:  obj.Content,
 		Task:     obj.Task,
 	}
+	setLessonBodyDTO(lesson, obj, format)
+
+	return lesson
 }
 
 func (s *serverAPI) Get(
 	ctx context.Context,
 	in *coursev1.GetCourseRequest,
 ) (*coursev1.GetCourseResponse, error) {
-	course, err := s.course.GetCourse(ctx, int(in.Id))
-	if err != nil {
-		return nil, status.Error(codes.Internal, ErrInternalServerError)
-	}
-
-	themes, err := s.course.GetThemes(ctx, course.ID)
+	course, err := s.course.GetCourseTree(ctx, int(in.Id))
 	if err != nil {
-		return nil, status.Error(codes.Internal, ErrInternalServerError)
+		return nil, toStatusError(err)
 	}
 
-	themesResp := make([]*coursev1.Theme, len(themes))
-	for i, theme := range themes {
-		lessons, err := s.course.GetLessons(ctx, course.ID, theme.ID)
-		if err != nil {
-			return nil, status.Error(codes.Internal, ErrInternalServerError)
-		}
-		lsResp := make([]*coursev1.Lesson, len(lessons))
-		for j, lesson := range lessons {
-			lsResp[j] = toLessonDTO(lesson)
+	themesResp := make([]*coursev1.Theme, len(course.Themes))
+	for i, theme := range course.Themes {
+		lsResp := make([]*coursev1.Lesson, len(theme.Lessons))
+		for j, lesson := range theme.Lessons {
+			lsResp[j] = toLessonDTO(lesson, in.Format)
 		}
 		themesResp[i] = toThemeDTO(theme, lsResp)
 	}
 
+	enrollment, err := s.enrollment.Enrollment(ctx, course.ID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toGetCourseResponse(course, themesResp, enrollment), nil
+}
+
+func toGetCourseResponse(course *entities.Course, themes []*coursev1.Theme, enrollment *entities.Enrollment) *coursev1.GetCourseResponse {
 	return &coursev1.GetCourseResponse{
-		Id:              int32(course.ID),
-		Title:           course.Title,
-		Description:     course.Description,
-		FullDescription: course.FullDescription,
-		Work:            course.Work,
-		Difficulty:      course.Difficulty,
-		Duration:        course.Duration,
-		Image:           course.Image,
-		Themes:          themesResp,
-	}, nil
+		Id:               int32(course.ID),
+		Title:            course.Title,
+		Description:      course.Description,
+		FullDescription:  course.FullDescription,
+		Work:             course.Work,
+		Difficulty:       course.Difficulty,
+		Duration:         course.Duration,
+		Image:            course.Image,
+		Themes:           themes,
+		Status:           toStatusDTO(course.Status),
+		Enrolled:         enrollment != nil && enrollment.Status != entities.EnrollmentDropped,
+		Enrollment:       toEnrollmentDTO(enrollment),
+		ComputedDuration: course.ComputedDuration,
+		DurationOverride: course.DurationOverride,
+	}
 }
 
 func (s *serverAPI) Delete(
@@ -196,7 +340,21 @@ func (s *serverAPI) Delete(
 ) (*coursev1.SuccessResponse, error) {
 	err := s.course.DeleteCourse(ctx, int(in.Id))
 	if err != nil {
-		return nil, status.Error(codes.Internal, ErrInternalServerError)
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) RestoreCourse(
+	ctx context.Context,
+	in *coursev1.RestoreCourseRequest,
+) (*coursev1.SuccessResponse, error) {
+	err := s.course.RestoreCourse(ctx, int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	return &coursev1.SuccessResponse{
@@ -204,22 +362,54 @@ func (s *serverAPI) Delete(
 	}, nil
 }
 
+func (s *serverAPI) ListDeletedCourses(
+	ctx context.Context,
+	in *emptypb.Empty,
+) (*coursev1.GetResponse, error) {
+	courses, err := s.course.ListDeletedCourses(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := make([]*coursev1.Course, len(courses))
+	for i, course := range courses {
+		response[i] = toCourseDTO(course)
+	}
+
+	return &coursev1.GetResponse{
+		Courses: response,
+	}, nil
+}
+
 func toCourseEntitieUpd(obj *coursev1.UpdateCourseRequest) *entities.Course {
+	themes := make([]*entities.Theme, len(obj.Themes))
+	for i, th := range obj.Themes {
+		themes[i] = toThemeEntitieUpd(th)
+	}
+
 	return &entities.Course{
-		ID:              int(obj.Id),
-		Title:           obj.Title,
-		Description:     obj.Description,
-		FullDescription: obj.FullDescription,
-		Work:            obj.Work,
-		Difficulty:      obj.Difficulty,
-		Duration:        obj.Duration,
-		Image:           obj.Image,
+		ID:               int(obj.Id),
+		Title:            obj.Title,
+		Description:      obj.Description,
+		FullDescription:  obj.FullDescription,
+		Work:             obj.Work,
+		Difficulty:       obj.Difficulty,
+		DurationOverride: obj.DurationOverride,
+		Image:            obj.Image,
+		Themes:           themes,
 	}
 }
 
 func toThemeEntitieUpd(obj *coursev1.UpdateTheme) *entities.Theme {
+	lessons := make([]*entities.Lesson, len(obj.Lessons))
+	for i, ls := range obj.Lessons {
+		lessons[i] = toLessonEntitieUpd(ls)
+	}
+
 	theme := &entities.Theme{
-		Title: obj.Title,
+		Title:            obj.Title,
+		DurationOverride: obj.DurationOverride,
+		Lessons:          lessons,
 	}
 
 	if obj.Id != nil {
@@ -232,11 +422,10 @@ func toThemeEntitieUpd(obj *coursev1.UpdateTheme) *entities.Theme {
 func toLessonEntitieUpd(obj *coursev1.UpdateLesson) *entities.Lesson {
 	lesson := &entities.Lesson{
 		Title:    obj.Title,
-		Type:     obj.Type,
 		Duration: obj.Duration,
-		Content:  obj.Content,
 		Task:     obj.Task,
 	}
+	setLessonBody(lesson, obj)
 
 	if obj.Id != nil {
 		lesson.ID = int(*obj.Id)
@@ -250,39 +439,195 @@ func (s *serverAPI) Update(
 	in *coursev1.UpdateCourseRequest,
 ) (*coursev1.SuccessResponse, error) {
 	course := toCourseEntitieUpd(in)
-	id, err := s.course.UpdateCourse(ctx, course)
+	_, err := s.course.UpdateCourse(ctx, course, entities.UpdateOptions{
+		Fields:  in.UpdateMask.GetPaths(),
+		Replace: in.Replace,
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, ErrInternalServerError)
-	}
-
-	for _, th := range in.Themes {
-		theme := toThemeEntitieUpd(th)
-		theme.CourseID = id
-		var themeID int
-		var err error
-		if th.Id == nil {
-			themeID, err = s.course.CreateTheme(ctx, theme)
-		} else {
-			themeID, err = s.course.UpdateTheme(ctx, theme)
-		}
-		if err != nil {
-			return nil, status.Error(codes.Internal, ErrInternalServerError)
-		}
-		for _, ls := range th.Lessons {
-			lesson := toLessonEntitieUpd(ls)
-			lesson.CourseID = id
-			lesson.ThemeID = themeID
-			if ls.Id == nil {
-				_, err = s.course.CreateLesson(ctx, lesson)
-			} else {
-				_, err = s.course.UpdateLesson(ctx, lesson)
-			}
-			if err != nil {
-				return nil, status.Error(codes.Internal, ErrInternalServerError)
-			}
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func toSyllabusOrder(obj *coursev1.ReorderRequest) *entities.SyllabusOrder {
+	order := &entities.SyllabusOrder{
+		CourseID: int(obj.CourseId),
+		ThemeIDs: toInts(obj.ThemeIds),
+		Lessons:  make([]entities.LessonOrder, len(obj.Lessons)),
+	}
+	for i, lo := range obj.Lessons {
+		order.Lessons[i] = entities.LessonOrder{
+			ThemeID:   int(lo.ThemeId),
+			LessonIDs: toInts(lo.LessonIds),
 		}
 	}
 
+	return order
+}
+
+func toInts(ids []int32) []int {
+	res := make([]int, len(ids))
+	for i, id := range ids {
+		res[i] = int(id)
+	}
+	return res
+}
+
+func toInt32s(ids []int) []int32 {
+	res := make([]int32, len(ids))
+	for i, id := range ids {
+		res[i] = int32(id)
+	}
+	return res
+}
+
+func (s *serverAPI) Reorder(
+	ctx context.Context,
+	in *coursev1.ReorderRequest,
+) (*coursev1.SuccessResponse, error) {
+	err := s.course.Reorder(ctx, toSyllabusOrder(in))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) CreateTheme(
+	ctx context.Context,
+	in *coursev1.CreateThemeRequest,
+) (*coursev1.CreatedTheme, error) {
+	theme := toThemeEntitie(in.Theme)
+	theme.CourseID = int(in.CourseId)
+	_, err := s.course.CreateTheme(ctx, theme)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	lessonIDs := make([]int32, len(theme.Lessons))
+	for i, ls := range theme.Lessons {
+		lessonIDs[i] = int32(ls.ID)
+	}
+
+	return &coursev1.CreatedTheme{
+		Id:        int32(theme.ID),
+		LessonIds: lessonIDs,
+	}, nil
+}
+
+func (s *serverAPI) GetTheme(
+	ctx context.Context,
+	in *coursev1.GetThemeRequest,
+) (*coursev1.Theme, error) {
+	theme, err := s.course.GetTheme(ctx, int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	lessons := make([]*coursev1.Lesson, len(theme.Lessons))
+	for i, lesson := range theme.Lessons {
+		lessons[i] = toLessonDTO(lesson, in.Format)
+	}
+
+	return toThemeDTO(theme, lessons), nil
+}
+
+func (s *serverAPI) UpdateTheme(
+	ctx context.Context,
+	in *coursev1.UpdateThemeRequest,
+) (*coursev1.SuccessResponse, error) {
+	_, err := s.course.UpdateTheme(ctx, &entities.Theme{
+		ID:               int(in.Id),
+		Title:            in.Title,
+		DurationOverride: in.DurationOverride,
+	}, in.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) DeleteTheme(
+	ctx context.Context,
+	in *coursev1.DeleteThemeRequest,
+) (*coursev1.SuccessResponse, error) {
+	err := s.course.DeleteTheme(ctx, int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) CreateLesson(
+	ctx context.Context,
+	in *coursev1.CreateLessonRequest,
+) (*coursev1.CreateLessonResponse, error) {
+	lesson := toLessonEntitie(in.Lesson)
+	lesson.ThemeID = int(in.ThemeId)
+	id, err := s.course.CreateLesson(ctx, lesson)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.CreateLessonResponse{
+		Id: int32(id),
+	}, nil
+}
+
+func (s *serverAPI) GetLesson(
+	ctx context.Context,
+	in *coursev1.GetLessonRequest,
+) (*coursev1.Lesson, error) {
+	lesson, err := s.course.GetLesson(ctx, int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toLessonDTO(lesson, in.Format), nil
+}
+
+func (s *serverAPI) UpdateLesson(
+	ctx context.Context,
+	in *coursev1.UpdateLessonRequest,
+) (*coursev1.SuccessResponse, error) {
+	lesson := &entities.Lesson{
+		ID:       int(in.Id),
+		Title:    in.Title,
+		Duration: in.Duration,
+		Task:     in.Task,
+	}
+	setLessonBody(lesson, in)
+
+	_, err := s.course.UpdateLesson(ctx, lesson, toLessonMask(in.UpdateMask.GetPaths()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.SuccessResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) DeleteLesson(
+	ctx context.Context,
+	in *coursev1.DeleteLessonRequest,
+) (*coursev1.SuccessResponse, error) {
+	err := s.course.DeleteLesson(ctx, int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
 	return &coursev1.SuccessResponse{
 		Success: true,
 	}, nil
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Enrollment interface {
+	Enroll(ctx context.Context, cid int) (*entities.Enrollment, error)
+	Unenroll(ctx context.Context, cid int) (*entities.Enrollment, error)
+	Enrollment(ctx context.Context, cid int) (*entities.Enrollment, error)
+	ListEnrollments(ctx context.Context, statuses []entities.EnrollmentStatus) ([]*entities.Enrollment, error)
+	ListCourseStudents(ctx context.Context, cid int, statuses []entities.EnrollmentStatus) ([]*entities.Enrollment, error)
+	MarkLessonStarted(ctx context.Context, lid int) (*entities.LessonProgress, error)
+	MarkLessonCompleted(ctx context.Context, lid int) (*entities.CourseProgress, error)
+	GetCourseProgress(ctx context.Context, cid, uid int) (*entities.CourseProgress, error)
+	SubmitTaskAnswer(ctx context.Context, lid int, answer entities.TaskAnswer) (*entities.Attempt, error)
+	ListAttempts(ctx context.Context, lid, uid int, all bool) ([]*entities.Attempt, error)
+	ReviewAttempt(ctx context.Context, id, score int) (*entities.Attempt, error)
+}
+
+var enrollmentStatusDTOs = map[entities.EnrollmentStatus]coursev1.EnrollmentStatus{
+	entities.EnrollmentActive:    coursev1.EnrollmentStatus_ENROLLMENT_STATUS_ACTIVE,
+	entities.EnrollmentCompleted: coursev1.EnrollmentStatus_ENROLLMENT_STATUS_COMPLETED,
+	entities.EnrollmentDropped:   coursev1.EnrollmentStatus_ENROLLMENT_STATUS_DROPPED,
+}
+
+var enrollmentStatusEntities = map[coursev1.EnrollmentStatus]entities.EnrollmentStatus{
+	coursev1.EnrollmentStatus_ENROLLMENT_STATUS_ACTIVE:    entities.EnrollmentActive,
+	coursev1.EnrollmentStatus_ENROLLMENT_STATUS_COMPLETED: entities.EnrollmentCompleted,
+	coursev1.EnrollmentStatus_ENROLLMENT_STATUS_DROPPED:   entities.EnrollmentDropped,
+}
+
+func toEnrollmentStatuses(statuses []coursev1.EnrollmentStatus) []entities.EnrollmentStatus {
+	res := make([]entities.EnrollmentStatus, 0, len(statuses))
+	for _, dto := range statuses {
+		if st, ok := enrollmentStatusEntities[dto]; ok {
+			res = append(res, st)
+		}
+	}
+	return res
+}
+
+func toEnrollmentDTO(obj *entities.Enrollment) *coursev1.Enrollment {
+	if obj == nil {
+		return nil
+	}
+
+	return &coursev1.Enrollment{
+		CourseId:   int32(obj.CourseID),
+		UserId:     int32(obj.UserID),
+		Status:     enrollmentStatusDTOs[obj.Status],
+		EnrolledAt: timestamppb.New(obj.EnrolledAt),
+		UpdatedAt:  timestamppb.New(obj.UpdatedAt),
+	}
+}
+
+func toEnrollmentsResponse(enrollments []*entities.Enrollment) *coursev1.ListEnrollmentsResponse {
+	res := make([]*coursev1.Enrollment, len(enrollments))
+	for i, e := range enrollments {
+		res[i] = toEnrollmentDTO(e)
+	}
+
+	return &coursev1.ListEnrollmentsResponse{Enrollments: res}
+}
+
+func (s *serverAPI) Enroll(
+	ctx context.Context,
+	in *coursev1.EnrollRequest,
+) (*coursev1.Enrollment, error) {
+	enrollment, err := s.enrollment.Enroll(ctx, int(in.CourseId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toEnrollmentDTO(enrollment), nil
+}
+
+func (s *serverAPI) Unenroll(
+	ctx context.Context,
+	in *coursev1.UnenrollRequest,
+) (*coursev1.Enrollment, error) {
+	enrollment, err := s.enrollment.Unenroll(ctx, int(in.CourseId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toEnrollmentDTO(enrollment), nil
+}
+
+func (s *serverAPI) ListEnrollments(
+	ctx context.Context,
+	in *coursev1.ListEnrollmentsRequest,
+) (*coursev1.ListEnrollmentsResponse, error) {
+	enrollments, err := s.enrollment.ListEnrollments(ctx, toEnrollmentStatuses(in.Statuses))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toEnrollmentsResponse(enrollments), nil
+}
+
+func (s *serverAPI) ListCourseStudents(
+	ctx context.Context,
+	in *coursev1.ListCourseStudentsRequest,
+) (*coursev1.ListEnrollmentsResponse, error) {
+	enrollments, err := s.enrollment.ListCourseStudents(ctx, int(in.CourseId), toEnrollmentStatuses(in.Statuses))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toEnrollmentsResponse(enrollments), nil
+}
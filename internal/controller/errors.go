@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const errorDomain = "course.orbitofsuccess"
+
+var kindCodes = map[entities.ErrorKind]codes.Code{
+	entities.KindInternal:           codes.Internal,
+	entities.KindInvalidArgument:    codes.InvalidArgument,
+	entities.KindNotFound:           codes.NotFound,
+	entities.KindAlreadyExists:      codes.AlreadyExists,
+	entities.KindFailedPrecondition: codes.FailedPrecondition,
+	entities.KindPermissionDenied:   codes.PermissionDenied,
+	entities.KindUnauthenticated:    codes.Unauthenticated,
+}
+
+// toStatusError converts an error returned by the service layer into a gRPC
+// status. Domain errors keep their message and carry ErrorInfo and BadRequest
+// details; anything else is reported as an opaque internal error.
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var domainErr *entities.Error
+	if !errors.As(err, &domainErr) {
+		return status.Error(codes.Internal, ErrInternalServerError)
+	}
+
+	code, ok := kindCodes[domainErr.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+
+	msg := domainErr.Message
+	if code == codes.Internal {
+		msg = ErrInternalServerError
+	}
+
+	st := status.New(code, msg)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   domainErr.Reason,
+			Domain:   errorDomain,
+			Metadata: domainErr.Metadata,
+		},
+	}
+	if len(domainErr.Violations) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(domainErr.Violations))
+		for i, v := range domainErr.Violations {
+			violations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			}
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
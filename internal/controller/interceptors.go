@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationUnaryInterceptor rejects requests that break the rules declared
+// in the validation package with InvalidArgument and field violations.
+func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := validation.Message(msg); err != nil {
+				return nil, toStatusError(err)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor is the streaming counterpart of
+// ValidationUnaryInterceptor. Every received message is validated.
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if msg, ok := m.(proto.Message); ok {
+		if err := validation.Message(msg); err != nil {
+			return toStatusError(err)
+		}
+	}
+
+	return nil
+}
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+// AuthUnaryInterceptor verifies the bearer token of the request, puts the
+// caller into the context and enforces the role policy of the RPC. Requests
+// without a token are anonymous and may only call public RPCs.
+func AuthUnaryInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor is the streaming counterpart of
+// AuthUnaryInterceptor.
+func AuthStreamInterceptor(verifier *auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func authorize(ctx context.Context, verifier *auth.Verifier, method string) (context.Context, error) {
+	roles, ok := policies[method]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+
+	token := bearerToken(ctx)
+	if token == "" {
+		if len(roles) > 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		return ctx, nil
+	}
+
+	p, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	if len(roles) > 0 && !p.HasRole(roles...) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to perform this action")
+	}
+
+	return auth.WithPrincipal(ctx, p), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, v := range md.Get(authorizationHeader) {
+		if len(v) > len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(v[len(bearerPrefix):])
+		}
+	}
+	return ""
+}
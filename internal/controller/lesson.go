@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"slices"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/markdown"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+)
+
+// lessonBodyField is the name of the lesson body oneof, which is also the
+// field mask path for the body.
+const lessonBodyField = "body"
+
+// lessonBody is implemented by every message with the lesson body oneof.
+type lessonBody interface {
+	GetText() *coursev1.TextBody
+	GetVideo() *coursev1.VideoBody
+	GetQuiz() *coursev1.QuizBody
+	GetAssignment() *coursev1.AssignmentBody
+	GetLink() *coursev1.LinkBody
+}
+
+// setLessonBody copies the body set in the oneof of in to obj.
+func setLessonBody(obj *entities.Lesson, in lessonBody) {
+	switch {
+	case in.GetText() != nil:
+		obj.SetBody(&entities.TextBody{
+			Markdown: in.GetText().Markdown,
+		})
+	case in.GetVideo() != nil:
+		obj.SetBody(&entities.VideoBody{
+			URL:             in.GetVideo().Url,
+			DurationSeconds: in.GetVideo().DurationSeconds,
+		})
+	case in.GetQuiz() != nil:
+		obj.SetBody(&entities.QuizBody{
+			Instructions: in.GetQuiz().Instructions,
+		})
+	case in.GetAssignment() != nil:
+		obj.SetBody(&entities.AssignmentBody{
+			Instructions: in.GetAssignment().Instructions,
+			DueDays:      in.GetAssignment().DueDays,
+		})
+	case in.GetLink() != nil:
+		obj.SetBody(&entities.LinkBody{
+			URL:   in.GetLink().Url,
+			Label: in.GetLink().Label,
+		})
+	}
+}
+
+// setLessonBodyDTO sets the oneof of dto to the body of obj. Text lessons
+// are returned in the given format.
+func setLessonBodyDTO(dto *coursev1.Lesson, obj *entities.Lesson, format coursev1.ContentFormat) {
+	switch {
+	case obj.Kind == entities.LessonText && obj.Text != nil:
+		dto.Body = &coursev1.Lesson_Text{Text: toTextBodyDTO(obj.Text, format)}
+	case obj.Kind == entities.LessonVideo && obj.Video != nil:
+		dto.Body = &coursev1.Lesson_Video{Video: &coursev1.VideoBody{
+			Url:             obj.Video.URL,
+			DurationSeconds: obj.Video.DurationSeconds,
+		}}
+	case obj.Kind == entities.LessonQuiz && obj.Quiz != nil:
+		dto.Body = &coursev1.Lesson_Quiz{Quiz: &coursev1.QuizBody{
+			Instructions: obj.Quiz.Instructions,
+		}}
+	case obj.Kind == entities.LessonAssignment && obj.Assignment != nil:
+		dto.Body = &coursev1.Lesson_Assignment{Assignment: &coursev1.AssignmentBody{
+			Instructions: obj.Assignment.Instructions,
+			DueDays:      obj.Assignment.DueDays,
+		}}
+	case obj.Kind == entities.LessonLink && obj.Link != nil:
+		dto.Body = &coursev1.Lesson_Link{Link: &coursev1.LinkBody{
+			Url:   obj.Link.URL,
+			Label: obj.Link.Label,
+		}}
+	}
+}
+
+// toLessonMask maps the kind names a client may use for the body in an
+// update mask to the body field.
+func toLessonMask(paths []string) []string {
+	res := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if slices.Contains(entities.LessonKinds, entities.LessonKind(p)) {
+			p = lessonBodyField
+		}
+		if !slices.Contains(res, p) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func toTextBodyDTO(obj *entities.TextBody, format coursev1.ContentFormat) *coursev1.TextBody {
+	body := &coursev1.TextBody{
+		Toc: make([]*coursev1.Heading, len(obj.TOC)),
+	}
+	for i, h := range obj.TOC {
+		body.Toc[i] = &coursev1.Heading{
+			Level: int32(h.Level),
+			Text:  h.Text,
+			Id:    h.ID,
+		}
+	}
+
+	switch format {
+	case coursev1.ContentFormat_CONTENT_FORMAT_HTML:
+		body.Html = obj.HTML
+	case coursev1.ContentFormat_CONTENT_FORMAT_PLAIN:
+		body.Plain = markdown.PlainText(obj.Markdown)
+	default:
+		body.Markdown = obj.Markdown
+	}
+
+	return body
+}
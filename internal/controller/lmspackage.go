@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/lmspackage"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/grpc"
+)
+
+var packageKinds = map[lmspackage.Kind]coursev1.PackageKind{
+	lmspackage.CommonCartridge: coursev1.PackageKind_PACKAGE_KIND_COMMON_CARTRIDGE,
+	lmspackage.SCORM12:         coursev1.PackageKind_PACKAGE_KIND_SCORM_12,
+}
+
+func (s *serverAPI) ImportPackage(
+	stream grpc.ClientStreamingServer[coursev1.ImportPackageRequest, coursev1.ImportPackageResponse],
+) error {
+	var (
+		info *coursev1.PackageInfo
+		data []byte
+	)
+	for first := true; ; first = false {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			if in.Info == nil {
+				return toStatusError(validation.ErrInvalidRequest.WithField("info", "is required"))
+			}
+			info = in.Info
+		}
+		if len(data)+len(in.Data) > lmspackage.MaxSize {
+			return toStatusError(lmspackage.ErrPackageTooLarge)
+		}
+		data = append(data, in.Data...)
+	}
+	if info == nil {
+		return toStatusError(validation.ErrInvalidRequest.WithField("info", "is required"))
+	}
+
+	pkg, err := s.course.ImportPackage(stream.Context(), data, lmspackage.Defaults{
+		Description:    info.Description,
+		Difficulty:     info.Difficulty,
+		Image:          info.Image,
+		LessonDuration: info.LessonDuration,
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	skipped := make([]*coursev1.SkippedItem, len(pkg.Skipped))
+	for i, item := range pkg.Skipped {
+		skipped[i] = &coursev1.SkippedItem{
+			Identifier: item.Identifier,
+			Title:      item.Title,
+			Reason:     item.Reason,
+		}
+	}
+
+	created := toCreateResponse(pkg.Course)
+	return stream.SendAndClose(&coursev1.ImportPackageResponse{
+		Id:      created.Id,
+		Kind:    packageKinds[pkg.Kind],
+		Themes:  created.Themes,
+		Skipped: skipped,
+	})
+}
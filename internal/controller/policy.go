@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+)
+
+var (
+	public  = []auth.Role{}
+	members = []auth.Role{auth.RoleStudent, auth.RoleAuthor, auth.RoleAdmin}
+	editors = []auth.Role{auth.RoleAuthor, auth.RoleAdmin}
+	admins  = []auth.Role{auth.RoleAdmin}
+)
+
+// policies lists the roles allowed to call each RPC. An empty list makes
+// the RPC public. RPCs missing from the map are denied, so a new RPC has
+// to be added here before anyone can call it. The role of the caller in
+// the course itself (owner, editor, reviewer) is checked by the service
+// layer on top of these roles.
+var policies = map[string][]auth.Role{
+	coursev1.CourseService_GetAll_FullMethodName:              public,
+	coursev1.CourseService_ListCourses_FullMethodName:         public,
+	coursev1.CourseService_SearchCourses_FullMethodName:       public,
+	coursev1.CourseService_Get_FullMethodName:                 public,
+	coursev1.CourseService_StreamCourses_FullMethodName:       public,
+	coursev1.CourseService_StreamCourseContent_FullMethodName: public,
+	coursev1.CourseService_GetTheme_FullMethodName:            public,
+	coursev1.CourseService_GetLesson_FullMethodName:           public,
+	coursev1.CourseService_GetLessonTask_FullMethodName:       public,
+
+	coursev1.CourseService_Enroll_FullMethodName:              members,
+	coursev1.CourseService_Unenroll_FullMethodName:            members,
+	coursev1.CourseService_ListEnrollments_FullMethodName:     members,
+	coursev1.CourseService_MarkLessonStarted_FullMethodName:   members,
+	coursev1.CourseService_MarkLessonCompleted_FullMethodName: members,
+	coursev1.CourseService_GetCourseProgress_FullMethodName:   members,
+	coursev1.CourseService_SubmitTaskAnswer_FullMethodName:    members,
+	coursev1.CourseService_ListAttempts_FullMethodName:        members,
+
+	coursev1.CourseService_Create_FullMethodName:             editors,
+	coursev1.CourseService_Update_FullMethodName:             editors,
+	coursev1.CourseService_Delete_FullMethodName:             editors,
+	coursev1.CourseService_RestoreCourse_FullMethodName:      editors,
+	coursev1.CourseService_Reorder_FullMethodName:            editors,
+	coursev1.CourseService_ExportCourse_FullMethodName:       editors,
+	coursev1.CourseService_ImportCourse_FullMethodName:       editors,
+	coursev1.CourseService_ImportPackage_FullMethodName:      editors,
+	coursev1.CourseService_SubmitForReview_FullMethodName:    editors,
+	coursev1.CourseService_Unpublish_FullMethodName:          editors,
+	coursev1.CourseService_Archive_FullMethodName:            editors,
+	coursev1.CourseService_CreateTheme_FullMethodName:        editors,
+	coursev1.CourseService_UpdateTheme_FullMethodName:        editors,
+	coursev1.CourseService_DeleteTheme_FullMethodName:        editors,
+	coursev1.CourseService_CreateLesson_FullMethodName:       editors,
+	coursev1.CourseService_UpdateLesson_FullMethodName:       editors,
+	coursev1.CourseService_DeleteLesson_FullMethodName:       editors,
+	coursev1.CourseService_ListRevisions_FullMethodName:      editors,
+	coursev1.CourseService_GetRevision_FullMethodName:        editors,
+	coursev1.CourseService_DiffRevisions_FullMethodName:      editors,
+	coursev1.CourseService_RevertToRevision_FullMethodName:   editors,
+	coursev1.CourseService_AddCoAuthor_FullMethodName:        editors,
+	coursev1.CourseService_RemoveCoAuthor_FullMethodName:     editors,
+	coursev1.CourseService_ListCoAuthors_FullMethodName:      editors,
+	coursev1.CourseService_ListMyCourses_FullMethodName:      editors,
+	coursev1.CourseService_ListCourseStudents_FullMethodName: editors,
+	coursev1.CourseService_SetLessonTask_FullMethodName:      editors,
+	coursev1.CourseService_ReviewAttempt_FullMethodName:      editors,
+
+	// Publishing is the end of the review, which is done by admins.
+	coursev1.CourseService_Publish_FullMethodName:            admins,
+	coursev1.CourseService_ListDeletedCourses_FullMethodName: admins,
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toLessonProgressDTO(obj *entities.LessonProgress) *coursev1.LessonProgress {
+	return &coursev1.LessonProgress{
+		LessonId:    int32(obj.LessonID),
+		CourseId:    int32(obj.CourseID),
+		StartedAt:   timestamppb.New(obj.StartedAt),
+		CompletedAt: toTimestampDTO(obj.CompletedAt),
+	}
+}
+
+func toCourseProgressDTO(obj *entities.CourseProgress) *coursev1.CourseProgress {
+	themes := make([]*coursev1.ThemeProgress, len(obj.Themes))
+	for i, th := range obj.Themes {
+		themes[i] = &coursev1.ThemeProgress{
+			ThemeId:           int32(th.ThemeID),
+			CompletedLessons:  int32(th.CompletedLessons),
+			TotalLessons:      int32(th.TotalLessons),
+			CompletedDuration: int32(th.CompletedDuration),
+			TotalDuration:     int32(th.TotalDuration),
+			Percent:           th.Percent,
+		}
+	}
+
+	return &coursev1.CourseProgress{
+		CourseId:          int32(obj.CourseID),
+		UserId:            int32(obj.UserID),
+		Themes:            themes,
+		CompletedLessons:  int32(obj.CompletedLessons),
+		TotalLessons:      int32(obj.TotalLessons),
+		CompletedDuration: int32(obj.CompletedDuration),
+		TotalDuration:     int32(obj.TotalDuration),
+		Percent:           obj.Percent,
+	}
+}
+
+func (s *serverAPI) MarkLessonStarted(
+	ctx context.Context,
+	in *coursev1.MarkLessonRequest,
+) (*coursev1.LessonProgress, error) {
+	progress, err := s.enrollment.MarkLessonStarted(ctx, int(in.LessonId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toLessonProgressDTO(progress), nil
+}
+
+func (s *serverAPI) MarkLessonCompleted(
+	ctx context.Context,
+	in *coursev1.MarkLessonRequest,
+) (*coursev1.CourseProgress, error) {
+	progress, err := s.enrollment.MarkLessonCompleted(ctx, int(in.LessonId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toCourseProgressDTO(progress), nil
+}
+
+func (s *serverAPI) GetCourseProgress(
+	ctx context.Context,
+	in *coursev1.GetCourseProgressRequest,
+) (*coursev1.CourseProgress, error) {
+	progress, err := s.enrollment.GetCourseProgress(ctx, int(in.CourseId), int(in.UserId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toCourseProgressDTO(progress), nil
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var revisionEntityDTOs = map[entities.RevisionEntity]coursev1.RevisionEntity{
+	entities.RevisionCourse: coursev1.RevisionEntity_REVISION_ENTITY_COURSE,
+	entities.RevisionLesson: coursev1.RevisionEntity_REVISION_ENTITY_LESSON,
+}
+
+var revisionEntities = map[coursev1.RevisionEntity]entities.RevisionEntity{
+	coursev1.RevisionEntity_REVISION_ENTITY_COURSE: entities.RevisionCourse,
+	coursev1.RevisionEntity_REVISION_ENTITY_LESSON: entities.RevisionLesson,
+}
+
+func toRevisionDTO(obj *entities.Revision) *coursev1.Revision {
+	return &coursev1.Revision{
+		Id:        int32(obj.ID),
+		Entity:    revisionEntityDTOs[obj.Entity],
+		EntityId:  int32(obj.EntityID),
+		CourseId:  int32(obj.CourseID),
+		Version:   int32(obj.Version),
+		Fields:    obj.Fields,
+		AuthorId:  int32(obj.AuthorID),
+		CreatedAt: timestamppb.New(obj.CreatedAt),
+	}
+}
+
+func (s *serverAPI) ListRevisions(
+	ctx context.Context,
+	in *coursev1.ListRevisionsRequest,
+) (*coursev1.ListRevisionsResponse, error) {
+	revisions, err := s.course.ListRevisions(ctx, revisionEntities[in.Entity], int(in.Id))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := make([]*coursev1.Revision, len(revisions))
+	for i, rev := range revisions {
+		res[i] = toRevisionDTO(rev)
+	}
+
+	return &coursev1.ListRevisionsResponse{Revisions: res}, nil
+}
+
+func (s *serverAPI) GetRevision(
+	ctx context.Context,
+	in *coursev1.GetRevisionRequest,
+) (*coursev1.Revision, error) {
+	rev, err := s.course.GetRevision(ctx, revisionEntities[in.Entity], int(in.Id), int(in.Version))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toRevisionDTO(rev), nil
+}
+
+func (s *serverAPI) DiffRevisions(
+	ctx context.Context,
+	in *coursev1.DiffRevisionsRequest,
+) (*coursev1.DiffRevisionsResponse, error) {
+	diffs, err := s.course.DiffRevisions(ctx, revisionEntities[in.Entity], int(in.Id), int(in.FromVersion), int(in.ToVersion))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := make([]*coursev1.FieldDiff, len(diffs))
+	for i, d := range diffs {
+		res[i] = &coursev1.FieldDiff{
+			Field:       d.Field,
+			OldValue:    d.OldValue,
+			NewValue:    d.NewValue,
+			UnifiedDiff: d.Unified,
+		}
+	}
+
+	return &coursev1.DiffRevisionsResponse{Changes: res}, nil
+}
+
+func (s *serverAPI) RevertToRevision(
+	ctx context.Context,
+	in *coursev1.RevertToRevisionRequest,
+) (*coursev1.Revision, error) {
+	rev, err := s.course.RevertToRevision(ctx, revisionEntities[in.Entity], int(in.Id), int(in.Version))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toRevisionDTO(rev), nil
+}
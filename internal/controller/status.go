@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var statusDTOs = map[entities.CourseStatus]coursev1.CourseStatus{
+	entities.StatusDraft:     coursev1.CourseStatus_COURSE_STATUS_DRAFT,
+	entities.StatusReview:    coursev1.CourseStatus_COURSE_STATUS_REVIEW,
+	entities.StatusPublished: coursev1.CourseStatus_COURSE_STATUS_PUBLISHED,
+	entities.StatusArchived:  coursev1.CourseStatus_COURSE_STATUS_ARCHIVED,
+}
+
+func toStatusDTO(st entities.CourseStatus) coursev1.CourseStatus {
+	return statusDTOs[st]
+}
+
+var statusEntities = map[coursev1.CourseStatus]entities.CourseStatus{
+	coursev1.CourseStatus_COURSE_STATUS_DRAFT:     entities.StatusDraft,
+	coursev1.CourseStatus_COURSE_STATUS_REVIEW:    entities.StatusReview,
+	coursev1.CourseStatus_COURSE_STATUS_PUBLISHED: entities.StatusPublished,
+	coursev1.CourseStatus_COURSE_STATUS_ARCHIVED:  entities.StatusArchived,
+}
+
+func toStatuses(statuses []coursev1.CourseStatus) []entities.CourseStatus {
+	res := make([]entities.CourseStatus, 0, len(statuses))
+	for _, dto := range statuses {
+		if st, ok := statusEntities[dto]; ok {
+			res = append(res, st)
+		}
+	}
+	return res
+}
+
+func (s *serverAPI) changeStatus(
+	ctx context.Context,
+	in *coursev1.ChangeCourseStatusRequest,
+	to entities.CourseStatus,
+) (*coursev1.ChangeCourseStatusResponse, error) {
+	t, err := s.course.ChangeStatus(ctx, int(in.Id), to)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &coursev1.ChangeCourseStatusResponse{
+		Id:             int32(t.CourseID),
+		PreviousStatus: toStatusDTO(t.From),
+		Status:         toStatusDTO(t.To),
+		ChangedAt:      timestamppb.New(t.CreatedAt),
+	}, nil
+}
+
+func (s *serverAPI) SubmitForReview(
+	ctx context.Context,
+	in *coursev1.ChangeCourseStatusRequest,
+) (*coursev1.ChangeCourseStatusResponse, error) {
+	return s.changeStatus(ctx, in, entities.StatusReview)
+}
+
+func (s *serverAPI) Publish(
+	ctx context.Context,
+	in *coursev1.ChangeCourseStatusRequest,
+) (*coursev1.ChangeCourseStatusResponse, error) {
+	return s.changeStatus(ctx, in, entities.StatusPublished)
+}
+
+func (s *serverAPI) Unpublish(
+	ctx context.Context,
+	in *coursev1.ChangeCourseStatusRequest,
+) (*coursev1.ChangeCourseStatusResponse, error) {
+	return s.changeStatus(ctx, in, entities.StatusDraft)
+}
+
+func (s *serverAPI) Archive(
+	ctx context.Context,
+	in *coursev1.ChangeCourseStatusRequest,
+) (*coursev1.ChangeCourseStatusResponse, error) {
+	return s.changeStatus(ctx, in, entities.StatusArchived)
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *serverAPI) StreamCourses(
+	in *emptypb.Empty,
+	stream grpc.ServerStreamingServer[coursev1.Course],
+) error {
+	err := s.course.StreamCourses(stream.Context(), func(course *entities.Course) error {
+		return stream.Send(toCourseDTO(course))
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	return nil
+}
+
+func (s *serverAPI) StreamCourseContent(
+	in *coursev1.GetCourseRequest,
+	stream grpc.ServerStreamingServer[coursev1.CourseContent],
+) error {
+	ctx := stream.Context()
+
+	enrollment, err := s.enrollment.Enrollment(ctx, int(in.Id))
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	err = s.course.StreamCourseContent(ctx, int(in.Id), func(item *entities.CourseContent) error {
+		var msg coursev1.CourseContent
+		switch {
+		case item.Course != nil:
+			msg.Item = &coursev1.CourseContent_Course{
+				Course: toGetCourseResponse(item.Course, nil, enrollment),
+			}
+		case item.Theme != nil:
+			msg.Item = &coursev1.CourseContent_Theme{
+				Theme: toThemeDTO(item.Theme, nil),
+			}
+		case item.Lesson != nil:
+			msg.Item = &coursev1.CourseContent_Lesson{
+				Lesson: toLessonDTO(item.Lesson, in.Format),
+			}
+		}
+		return stream.Send(&msg)
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	return nil
+}
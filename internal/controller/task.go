@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	coursev1 "github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/proto/gen/course"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var attemptStatusDTOs = map[entities.AttemptStatus]coursev1.AttemptStatus{
+	entities.AttemptGraded:        coursev1.AttemptStatus_ATTEMPT_STATUS_GRADED,
+	entities.AttemptPendingReview: coursev1.AttemptStatus_ATTEMPT_STATUS_PENDING_REVIEW,
+}
+
+func toTaskEntitie(obj *coursev1.TaskSpec) *entities.Task {
+	task := &entities.Task{
+		LessonID: int(obj.LessonId),
+		MaxScore: int(obj.MaxScore),
+	}
+
+	switch body := obj.Body.(type) {
+	case *coursev1.TaskSpec_SingleChoice:
+		task.Kind = entities.TaskSingleChoice
+		task.Choice = toChoiceTaskEntitie(body.SingleChoice)
+	case *coursev1.TaskSpec_MultipleChoice:
+		task.Kind = entities.TaskMultipleChoice
+		task.Choice = toChoiceTaskEntitie(body.MultipleChoice)
+	case *coursev1.TaskSpec_FreeText:
+		task.Kind = entities.TaskFreeText
+		task.FreeText = &entities.FreeTextTask{
+			Question:        body.FreeText.Question,
+			AcceptedAnswers: body.FreeText.AcceptedAnswers,
+			CaseSensitive:   body.FreeText.CaseSensitive,
+		}
+	case *coursev1.TaskSpec_Code:
+		task.Kind = entities.TaskCode
+		task.Code = &entities.CodeTask{
+			Statement:      body.Code.Statement,
+			Language:       body.Code.Language,
+			ExpectedOutput: body.Code.ExpectedOutput,
+		}
+	}
+
+	return task
+}
+
+func toChoiceTaskEntitie(obj *coursev1.ChoiceTask) *entities.ChoiceTask {
+	return &entities.ChoiceTask{
+		Question: obj.Question,
+		Options:  obj.Options,
+		Correct:  toInts(obj.Correct),
+	}
+}
+
+func toTaskDTO(obj *entities.Task) *coursev1.TaskSpec {
+	res := &coursev1.TaskSpec{
+		LessonId: int32(obj.LessonID),
+		MaxScore: int32(obj.MaxScore),
+	}
+
+	switch obj.Kind {
+	case entities.TaskSingleChoice:
+		res.Body = &coursev1.TaskSpec_SingleChoice{SingleChoice: toChoiceTaskDTO(obj.Choice)}
+	case entities.TaskMultipleChoice:
+		res.Body = &coursev1.TaskSpec_MultipleChoice{MultipleChoice: toChoiceTaskDTO(obj.Choice)}
+	case entities.TaskFreeText:
+		res.Body = &coursev1.TaskSpec_FreeText{FreeText: &coursev1.FreeTextTask{
+			Question:        obj.FreeText.Question,
+			AcceptedAnswers: obj.FreeText.AcceptedAnswers,
+			CaseSensitive:   obj.FreeText.CaseSensitive,
+		}}
+	case entities.TaskCode:
+		res.Body = &coursev1.TaskSpec_Code{Code: &coursev1.CodeTask{
+			Statement:      obj.Code.Statement,
+			Language:       obj.Code.Language,
+			ExpectedOutput: obj.Code.ExpectedOutput,
+		}}
+	}
+
+	return res
+}
+
+func toChoiceTaskDTO(obj *entities.ChoiceTask) *coursev1.ChoiceTask {
+	return &coursev1.ChoiceTask{
+		Question: obj.Question,
+		Options:  obj.Options,
+		Correct:  toInt32s(obj.Correct),
+	}
+}
+
+func toTaskAnswerEntitie(obj *coursev1.TaskAnswer) entities.TaskAnswer {
+	return entities.TaskAnswer{
+		Choices: toInts(obj.GetChoices()),
+		Text:    obj.GetText(),
+		Source:  obj.GetSource(),
+		Output:  obj.GetOutput(),
+	}
+}
+
+func toAttemptDTO(obj *entities.Attempt) *coursev1.Attempt {
+	return &coursev1.Attempt{
+		Id:       int32(obj.ID),
+		LessonId: int32(obj.LessonID),
+		UserId:   int32(obj.UserID),
+		Answer: &coursev1.TaskAnswer{
+			Choices: toInt32s(obj.Answer.Choices),
+			Text:    obj.Answer.Text,
+			Source:  obj.Answer.Source,
+			Output:  obj.Answer.Output,
+		},
+		Score:     int32(obj.Score),
+		MaxScore:  int32(obj.MaxScore),
+		Status:    attemptStatusDTOs[obj.Status],
+		CreatedAt: timestamppb.New(obj.CreatedAt),
+	}
+}
+
+func (s *serverAPI) SetLessonTask(
+	ctx context.Context,
+	in *coursev1.TaskSpec,
+) (*coursev1.TaskSpec, error) {
+	task := toTaskEntitie(in)
+
+	err := s.course.SetTask(ctx, task)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toTaskDTO(task), nil
+}
+
+func (s *serverAPI) GetLessonTask(
+	ctx context.Context,
+	in *coursev1.GetLessonTaskRequest,
+) (*coursev1.TaskSpec, error) {
+	task, err := s.course.GetTask(ctx, int(in.LessonId))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toTaskDTO(task), nil
+}
+
+func (s *serverAPI) SubmitTaskAnswer(
+	ctx context.Context,
+	in *coursev1.SubmitTaskAnswerRequest,
+) (*coursev1.Attempt, error) {
+	attempt, err := s.enrollment.SubmitTaskAnswer(ctx, int(in.LessonId), toTaskAnswerEntitie(in.Answer))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toAttemptDTO(attempt), nil
+}
+
+func (s *serverAPI) ListAttempts(
+	ctx context.Context,
+	in *coursev1.ListAttemptsRequest,
+) (*coursev1.ListAttemptsResponse, error) {
+	attempts, err := s.enrollment.ListAttempts(ctx, int(in.LessonId), int(in.UserId), in.AllUsers)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := make([]*coursev1.Attempt, len(attempts))
+	for i, a := range attempts {
+		res[i] = toAttemptDTO(a)
+	}
+
+	return &coursev1.ListAttemptsResponse{Attempts: res}, nil
+}
+
+func (s *serverAPI) ReviewAttempt(
+	ctx context.Context,
+	in *coursev1.ReviewAttemptRequest,
+) (*coursev1.Attempt, error) {
+	attempt, err := s.enrollment.ReviewAttempt(ctx, int(in.AttemptId), int(in.Score))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toAttemptDTO(attempt), nil
+}
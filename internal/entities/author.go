@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+type AuthorRole string
+
+const (
+	AuthorOwner    AuthorRole = "owner"
+	AuthorEditor   AuthorRole = "editor"
+	AuthorReviewer AuthorRole = "reviewer"
+)
+
+// CanEdit reports whether authors with this role may change the content of
+// the course.
+func (r AuthorRole) CanEdit() bool {
+	return r == AuthorOwner || r == AuthorEditor
+}
+
+// CoAuthor is a user working on a course. Every course created by an
+// authenticated user has exactly one owner.
+type CoAuthor struct {
+	CourseID int
+	UserID   int
+	Role     AuthorRole
+	AddedAt  time.Time
+}
@@ -0,0 +1,29 @@
+package entities
+
+type CourseSortField string
+
+const (
+	SortByCreatedAt CourseSortField = "created_at"
+	SortByTitle     CourseSortField = "title"
+	SortByDuration  CourseSortField = "duration"
+)
+
+// CourseListParams describes one page of the course catalog. Zero values
+// mean "no filter"; Cursor is the opaque token returned with the previous
+// page.
+type CourseListParams struct {
+	Limit        int
+	Cursor       string
+	Statuses     []CourseStatus
+	Difficulties []string
+	MinDuration  int32
+	MaxDuration  int32
+	SortBy       CourseSortField
+	Desc         bool
+}
+
+type CoursePage struct {
+	Courses    []*Course
+	NextCursor string
+	Total      int
+}
@@ -1,29 +1,101 @@
 package entities
 
+import "time"
+
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+var Difficulties = []string{DifficultyEasy, DifficultyMedium, DifficultyHard}
+
+// Course and theme durations are computed from their lessons.
+// ComputedDuration is the sum of the lesson durations and Duration the
+// value shown to students: DurationOverride if an author set one,
+// ComputedDuration otherwise.
+//
+// ExternalKey identifies a course, theme or lesson across environments. It
+// is kept when a course is exported and imported elsewhere.
 type Course struct {
-	ID              int
-	Title           string
-	Description     string
-	FullDescription string
-	Work            string
-	Difficulty      string
-	Duration        int32
-	Image           string
+	ID               int
+	Title            string
+	Description      string
+	FullDescription  string
+	Work             string
+	Difficulty       string
+	Duration         int32
+	ComputedDuration int32
+	DurationOverride *int32
+	Image            string
+	Status           CourseStatus
+	CreatedAt        time.Time
+	DeletedAt        *time.Time
+	OwnerID          int
+	ExternalKey      string
+	Themes           []*Theme
 }
 
 type Theme struct {
-	ID       int
-	CourseID int
-	Title    string
+	ID               int
+	CourseID         int
+	Title            string
+	Duration         int32
+	ComputedDuration int32
+	DurationOverride *int32
+	Position         int
+	ExternalKey      string
+	Lessons          []*Lesson
 }
 
+// Lesson is one step of a theme. Exactly one of Text, Video, Quiz,
+// Assignment and Link is set, matching Kind.
 type Lesson struct {
-	ID       int
+	ID          int
+	CourseID    int
+	ThemeID     int
+	Title       string
+	Kind        LessonKind
+	Duration    int32
+	Text        *TextBody
+	Video       *VideoBody
+	Quiz        *QuizBody
+	Assignment  *AssignmentBody
+	Link        *LinkBody
+	Task        string
+	Position    int
+	ExternalKey string
+	// TaskSpec is the typed task of the lesson. Only archives carry it
+	// along with the lesson; everything else reads it with GetTask.
+	TaskSpec *Task
+}
+
+// CourseContent is one item of a streamed course. Exactly one field is
+// set. The course comes first without themes, then every theme without
+// lessons, each followed by its own lessons.
+type CourseContent struct {
+	Course *Course
+	Theme  *Theme
+	Lesson *Lesson
+}
+
+// UpdateOptions controls how UpdateCourse applies a request. Fields lists
+// the fields to write, named as in the proto definition; empty means all.
+// Replace deletes themes and lessons that are not part of the update.
+type UpdateOptions struct {
+	Fields  []string
+	Replace bool
+}
+
+// SyllabusOrder is the new order of a course's themes and, per theme, of its
+// lessons. Empty lists leave the current order untouched.
+type SyllabusOrder struct {
 	CourseID int
-	ThemeID  int
-	Title    string
-	Type     string
-	Duration int32
-	Content  string
-	Task     string
+	ThemeIDs []int
+	Lessons  []LessonOrder
+}
+
+type LessonOrder struct {
+	ThemeID   int
+	LessonIDs []int
 }
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+type EnrollmentStatus string
+
+const (
+	EnrollmentActive    EnrollmentStatus = "active"
+	EnrollmentCompleted EnrollmentStatus = "completed"
+	EnrollmentDropped   EnrollmentStatus = "dropped"
+)
+
+// Enrollment is a student taking a course. A dropped enrollment is kept so
+// the student can come back to the course later.
+type Enrollment struct {
+	CourseID   int
+	UserID     int
+	Status     EnrollmentStatus
+	EnrolledAt time.Time
+	UpdatedAt  time.Time
+}
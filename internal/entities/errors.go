@@ -0,0 +1,79 @@
+package entities
+
+// ErrorKind classifies a domain error independently of the transport, so the
+// controller can pick the matching status code.
+type ErrorKind int
+
+const (
+	KindInternal ErrorKind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindAlreadyExists
+	KindFailedPrecondition
+	KindPermissionDenied
+	KindUnauthenticated
+)
+
+// FieldViolation describes a single invalid field of a request.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error. Reason is a stable, machine readable identifier
+// (e.g. COURSE_NOT_FOUND); Message is safe to show to the caller.
+type Error struct {
+	Kind       ErrorKind
+	Reason     string
+	Message    string
+	Violations []FieldViolation
+	Metadata   map[string]string
+}
+
+func NewError(kind ErrorKind, reason, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is a domain error of the same kind and reason,
+// so copies made by WithField or WithMetadata still match their sentinel.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	return e.Kind == t.Kind && e.Reason == t.Reason
+}
+
+// WithField returns a copy of the error with an extra field violation.
+func (e *Error) WithField(field, description string) *Error {
+	cp := e.clone()
+	cp.Violations = append(cp.Violations, FieldViolation{Field: field, Description: description})
+	return cp
+}
+
+// WithMetadata returns a copy of the error with an extra metadata entry.
+func (e *Error) WithMetadata(key, value string) *Error {
+	cp := e.clone()
+	cp.Metadata[key] = value
+	return cp
+}
+
+func (e *Error) clone() *Error {
+	cp := *e
+	cp.Violations = append([]FieldViolation(nil), e.Violations...)
+	cp.Metadata = make(map[string]string, len(e.Metadata)+1)
+	for k, v := range e.Metadata {
+		cp.Metadata[k] = v
+	}
+
+	return &cp
+}
@@ -0,0 +1,107 @@
+package entities
+
+type LessonKind string
+
+const (
+	LessonText       LessonKind = "text"
+	LessonVideo      LessonKind = "video"
+	LessonQuiz       LessonKind = "quiz"
+	LessonAssignment LessonKind = "assignment"
+	LessonLink       LessonKind = "link"
+)
+
+var LessonKinds = []LessonKind{LessonText, LessonVideo, LessonQuiz, LessonAssignment, LessonLink}
+
+// TextBody is written in markdown. HTML and TOC are rendered from it when
+// the lesson is saved; HTML is sanitized. The html key is always stored,
+// even when empty, so rendered bodies can be told from ones saved before
+// rendering existed.
+type TextBody struct {
+	Markdown string    `json:"markdown"`
+	HTML     string    `json:"html"`
+	TOC      []Heading `json:"toc,omitempty"`
+}
+
+// Heading is a table of contents entry. ID is its anchor in the HTML.
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
+
+// VideoBody points at a hosted video. DurationSeconds is 0 when unknown.
+type VideoBody struct {
+	URL             string `json:"url"`
+	DurationSeconds int32  `json:"duration_seconds,omitempty"`
+}
+
+// QuizBody introduces a quiz; the questions are the lesson task.
+type QuizBody struct {
+	Instructions string `json:"instructions"`
+}
+
+// AssignmentBody describes work handed in outside of the service. DueDays
+// counts from enrollment, 0 means no deadline.
+type AssignmentBody struct {
+	Instructions string `json:"instructions"`
+	DueDays      int32  `json:"due_days,omitempty"`
+}
+
+type LinkBody struct {
+	URL   string `json:"url"`
+	Label string `json:"label,omitempty"`
+}
+
+// Body returns the body that matches the kind of the lesson, or nil.
+func (l *Lesson) Body() any {
+	switch l.Kind {
+	case LessonText:
+		return l.Text
+	case LessonVideo:
+		return l.Video
+	case LessonQuiz:
+		return l.Quiz
+	case LessonAssignment:
+		return l.Assignment
+	case LessonLink:
+		return l.Link
+	}
+	return nil
+}
+
+// SetBody sets the kind and the matching body of the lesson and clears the
+// others. Unknown bodies leave the lesson without one.
+func (l *Lesson) SetBody(body any) {
+	l.Kind = ""
+	l.Text, l.Video, l.Quiz, l.Assignment, l.Link = nil, nil, nil, nil, nil
+
+	switch b := body.(type) {
+	case *TextBody:
+		l.Kind, l.Text = LessonText, b
+	case *VideoBody:
+		l.Kind, l.Video = LessonVideo, b
+	case *QuizBody:
+		l.Kind, l.Quiz = LessonQuiz, b
+	case *AssignmentBody:
+		l.Kind, l.Assignment = LessonAssignment, b
+	case *LinkBody:
+		l.Kind, l.Link = LessonLink, b
+	}
+}
+
+// NewLessonBody returns an empty body of the given kind, for decoding into.
+func NewLessonBody(kind LessonKind) any {
+	switch kind {
+	case LessonText:
+		return &TextBody{}
+	case LessonVideo:
+		return &VideoBody{}
+	case LessonQuiz:
+		return &QuizBody{}
+	case LessonAssignment:
+		return &AssignmentBody{}
+	case LessonLink:
+		return &LinkBody{}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package entities
+
+import "time"
+
+// LessonProgress is one student's work on one lesson. CompletedAt is nil
+// until the lesson is completed.
+type LessonProgress struct {
+	UserID      int
+	LessonID    int
+	CourseID    int
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// ThemeProgress counts the completed lessons of a theme. Durations are the
+// sums of Lesson.Duration.
+type ThemeProgress struct {
+	ThemeID           int
+	CompletedLessons  int
+	TotalLessons      int
+	CompletedDuration int
+	TotalDuration     int
+	Percent           float32
+}
+
+// CourseProgress sums up the progress of a student in a course. Percent is
+// weighted by lesson duration.
+type CourseProgress struct {
+	CourseID          int
+	UserID            int
+	Themes            []*ThemeProgress
+	CompletedLessons  int
+	TotalLessons      int
+	CompletedDuration int
+	TotalDuration     int
+	Percent           float32
+}
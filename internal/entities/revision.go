@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type RevisionEntity string
+
+const (
+	RevisionCourse RevisionEntity = "course"
+	RevisionLesson RevisionEntity = "lesson"
+)
+
+// Revision is an immutable snapshot of a course or lesson taken after each
+// write. Fields holds the editable fields, named as in the proto
+// definition. AuthorID is 0 when the change was made anonymously.
+type Revision struct {
+	ID        int
+	Entity    RevisionEntity
+	EntityID  int
+	CourseID  int
+	Version   int
+	Fields    map[string]string
+	AuthorID  int
+	CreatedAt time.Time
+}
+
+// FieldDiff describes how one field changed between two revisions. Unified
+// is a line diff of the two values.
+type FieldDiff struct {
+	Field    string
+	OldValue string
+	NewValue string
+	Unified  string
+}
+
+func CourseSnapshot(obj *Course) map[string]string {
+	return map[string]string{
+		"title":             obj.Title,
+		"description":       obj.Description,
+		"full_description":  obj.FullDescription,
+		"work":              obj.Work,
+		"difficulty":        obj.Difficulty,
+		"duration_override": itoa32(obj.DurationOverride),
+		"image":             obj.Image,
+	}
+}
+
+// ApplyCourseSnapshot writes the fields of a snapshot back into obj.
+func ApplyCourseSnapshot(obj *Course, fields map[string]string) {
+	obj.Title = fields["title"]
+	obj.Description = fields["description"]
+	obj.FullDescription = fields["full_description"]
+	obj.Work = fields["work"]
+	obj.Difficulty = fields["difficulty"]
+	obj.DurationOverride = nil
+	if d := fields["duration_override"]; d != "" {
+		v := atoi32(d)
+		obj.DurationOverride = &v
+	}
+	obj.Image = fields["image"]
+}
+
+// LessonSnapshot stores the body as indented JSON so that diffs between
+// revisions stay readable. Rendered markdown is left out, it is derived
+// from the source.
+func LessonSnapshot(obj *Lesson) map[string]string {
+	var b any = obj.Body()
+	if obj.Kind == LessonText && obj.Text != nil {
+		b = &TextBody{Markdown: obj.Text.Markdown}
+	}
+
+	body, _ := json.MarshalIndent(b, "", "  ")
+	return map[string]string{
+		"title":    obj.Title,
+		"kind":     string(obj.Kind),
+		"duration": strconv.Itoa(int(obj.Duration)),
+		"body":     string(body),
+		"task":     obj.Task,
+	}
+}
+
+// ApplyLessonSnapshot writes the fields of a snapshot back into obj.
+// Snapshots taken before lessons had kinds keep their content as markdown.
+func ApplyLessonSnapshot(obj *Lesson, fields map[string]string) {
+	obj.Title = fields["title"]
+	obj.Duration = atoi32(fields["duration"])
+	obj.Task = fields["task"]
+
+	kind, ok := fields["kind"]
+	if !ok {
+		obj.SetBody(&TextBody{Markdown: fields["content"]})
+		return
+	}
+
+	body := NewLessonBody(LessonKind(kind))
+	if body != nil && json.Unmarshal([]byte(fields["body"]), body) == nil {
+		obj.SetBody(body)
+		return
+	}
+	obj.SetBody(nil)
+}
+
+// itoa32 formats an optional value, nil becomes an empty string.
+func itoa32(v *int32) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*v))
+}
+
+func atoi32(s string) int32 {
+	n, _ := strconv.Atoi(s)
+	return int32(n)
+}
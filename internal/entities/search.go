@@ -0,0 +1,40 @@
+package entities
+
+type SearchMatchKind string
+
+const (
+	MatchTheme  SearchMatchKind = "theme"
+	MatchLesson SearchMatchKind = "lesson"
+)
+
+// SearchParams describes a search request. Statuses limits the found
+// courses; empty means any status.
+type SearchParams struct {
+	Query    string
+	Limit    int
+	Offset   int
+	Statuses []CourseStatus
+}
+
+// SearchMatch is a theme or lesson of a found course that matched the query
+// on its own. Snippet is escaped HTML with the matched words wrapped in
+// <mark> tags.
+type SearchMatch struct {
+	Kind    SearchMatchKind
+	ID      int
+	ThemeID int
+	Title   string
+	Snippet string
+}
+
+type SearchHit struct {
+	Course  *Course
+	Rank    float32
+	Snippet string
+	Matches []SearchMatch
+}
+
+type SearchResult struct {
+	Hits  []*SearchHit
+	Total int
+}
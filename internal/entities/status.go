@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+type CourseStatus string
+
+const (
+	StatusDraft     CourseStatus = "draft"
+	StatusReview    CourseStatus = "review"
+	StatusPublished CourseStatus = "published"
+	StatusArchived  CourseStatus = "archived"
+)
+
+// courseTransitions lists the statuses a course may move to from each
+// status.
+var courseTransitions = map[CourseStatus][]CourseStatus{
+	StatusDraft:     {StatusReview, StatusPublished, StatusArchived},
+	StatusReview:    {StatusDraft, StatusPublished, StatusArchived},
+	StatusPublished: {StatusDraft, StatusArchived},
+	StatusArchived:  {StatusDraft},
+}
+
+func (s CourseStatus) CanTransitionTo(to CourseStatus) bool {
+	return slices.Contains(courseTransitions[s], to)
+}
+
+// StatusTransition is one recorded change of a course status. ActorID is 0
+// when the change was made by an anonymous caller.
+type StatusTransition struct {
+	CourseID  int
+	From      CourseStatus
+	To        CourseStatus
+	ActorID   int
+	CreatedAt time.Time
+}
@@ -0,0 +1,103 @@
+package entities
+
+import "time"
+
+type TaskKind string
+
+const (
+	TaskSingleChoice   TaskKind = "single_choice"
+	TaskMultipleChoice TaskKind = "multiple_choice"
+	TaskFreeText       TaskKind = "free_text"
+	TaskCode           TaskKind = "code"
+)
+
+// Task is the gradable exercise of a lesson. Exactly one of Choice,
+// FreeText and Code is set, matching Kind; the choice body is shared by
+// both choice kinds.
+type Task struct {
+	LessonID int
+	CourseID int
+	Kind     TaskKind
+	MaxScore int
+	Choice   *ChoiceTask
+	FreeText *FreeTextTask
+	Code     *CodeTask
+}
+
+// ChoiceTask lists the options of a choice question. Correct holds the
+// indices of the right options.
+type ChoiceTask struct {
+	Question string   `json:"question"`
+	Options  []string `json:"options"`
+	Correct  []int    `json:"correct"`
+}
+
+// FreeTextTask is graded against the accepted answers. Without accepted
+// answers every attempt waits for an author to review it.
+type FreeTextTask struct {
+	Question        string   `json:"question"`
+	AcceptedAnswers []string `json:"accepted_answers,omitempty"`
+	CaseSensitive   bool     `json:"case_sensitive,omitempty"`
+}
+
+// CodeTask is always graded by a reviewer, who can compare the output the
+// student reports with ExpectedOutput. The service never runs submitted
+// code.
+type CodeTask struct {
+	Statement      string `json:"statement"`
+	Language       string `json:"language"`
+	ExpectedOutput string `json:"expected_output"`
+}
+
+// HideSolution removes everything that gives the answer away, for showing
+// the task to students.
+func (t *Task) HideSolution() *Task {
+	res := *t
+	if t.Choice != nil {
+		choice := *t.Choice
+		choice.Correct = nil
+		res.Choice = &choice
+	}
+	if t.FreeText != nil {
+		text := *t.FreeText
+		text.AcceptedAnswers = nil
+		res.FreeText = &text
+	}
+	if t.Code != nil {
+		code := *t.Code
+		code.ExpectedOutput = ""
+		res.Code = &code
+	}
+	return &res
+}
+
+type AttemptStatus string
+
+const (
+	AttemptGraded        AttemptStatus = "graded"
+	AttemptPendingReview AttemptStatus = "pending_review"
+)
+
+// TaskAnswer is what a student submits. Only the fields of the task kind
+// are used: Choices for choice tasks, Text for free text, Source and
+// Output for code. Output is what the student says their program printed
+// and is informational only.
+type TaskAnswer struct {
+	Choices []int  `json:"choices,omitempty"`
+	Text    string `json:"text,omitempty"`
+	Source  string `json:"source,omitempty"`
+	Output  string `json:"output,omitempty"`
+}
+
+// Attempt is one graded (or pending) submission of a task.
+type Attempt struct {
+	ID        int
+	LessonID  int
+	CourseID  int
+	UserID    int
+	Answer    TaskAnswer
+	Score     int
+	MaxScore  int
+	Status    AttemptStatus
+	CreatedAt time.Time
+}
@@ -0,0 +1,243 @@
+package lmspackage
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	spaces     = regexp.MustCompile(`\s+`)
+	emptyLines = regexp.MustCompile(`(?m)^[ \t]+$`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToMarkdown converts the body of a web page into markdown. Headings,
+// paragraphs, lists, emphasis, code, quotes and links are kept; images and
+// links are kept only when they point outside of the package, since its
+// files are not hosted. Scripts, styles and forms are dropped, other
+// elements are reduced to their text.
+func htmlToMarkdown(page []byte) (string, error) {
+	doc, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return "", err
+	}
+
+	body := find(doc, atom.Body)
+	if body == nil {
+		body = doc
+	}
+
+	return render(body), nil
+}
+
+// render converts the content of n into markdown blocks.
+func render(n *html.Node) string {
+	w := &mdWriter{}
+	w.children(n)
+	md := emptyLines.ReplaceAllString(w.buf.String(), "")
+	return strings.TrimSpace(blankLines.ReplaceAllString(md, "\n\n"))
+}
+
+// indent puts prefix in front of every line of s but the first, which
+// starts with first instead. Trailing spaces of prefix are left out on
+// empty lines.
+func indent(s, first, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		switch {
+		case i == 0:
+			lines[i] = first + l
+		case l == "":
+			lines[i] = strings.TrimRight(prefix, " ")
+		default:
+			lines[i] = prefix + l
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func find(n *html.Node, a atom.Atom) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := find(c, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+type mdWriter struct {
+	buf bytes.Buffer
+	pre bool
+}
+
+func (w *mdWriter) write(s string) {
+	w.buf.WriteString(s)
+}
+
+// afterSpace reports whether the output is empty or ends with white space,
+// so that the white space between elements is not repeated.
+func (w *mdWriter) afterSpace() bool {
+	b := w.buf.Bytes()
+	return len(b) == 0 || b[len(b)-1] == ' ' || b[len(b)-1] == '\n'
+}
+
+func (w *mdWriter) block(fn func()) {
+	w.write("\n\n")
+	fn()
+	w.write("\n\n")
+}
+
+func (w *mdWriter) children(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		w.node(c)
+	}
+}
+
+func (w *mdWriter) node(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		if w.pre {
+			w.write(n.Data)
+			return
+		}
+		text := spaces.ReplaceAllString(n.Data, " ")
+		if w.afterSpace() {
+			text = strings.TrimLeft(text, " ")
+		}
+		w.write(escape(text))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Noscript, atom.Template, atom.Form,
+		atom.Button, atom.Input, atom.Select, atom.Textarea, atom.Iframe, atom.Object, atom.Embed:
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		w.block(func() {
+			w.write(strings.Repeat("#", level) + " " + strings.TrimSpace(inline(n)))
+		})
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Main, atom.Table:
+		w.block(func() { w.children(n) })
+	case atom.Br:
+		w.write("  \n")
+	case atom.Hr:
+		w.block(func() { w.write("---") })
+	case atom.Strong, atom.B:
+		w.wrap(n, "**")
+	case atom.Em, atom.I:
+		w.wrap(n, "*")
+	case atom.Code:
+		if w.pre {
+			w.children(n)
+		} else {
+			w.wrap(n, "`")
+		}
+	case atom.Pre:
+		w.block(func() {
+			w.write("```\n")
+			w.pre = true
+			w.children(n)
+			w.pre = false
+			w.write("\n```")
+		})
+	case atom.Blockquote:
+		if quote := render(n); quote != "" {
+			w.block(func() { w.write(indent(quote, "> ", "> ")) })
+		}
+	case atom.Ul, atom.Ol:
+		w.block(func() { w.list(n) })
+	case atom.Tr:
+		var cells []string
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && (c.DataAtom == atom.Td || c.DataAtom == atom.Th) {
+				cells = append(cells, strings.TrimSpace(inline(c)))
+			}
+		}
+		w.write("\n" + strings.Join(cells, " | ") + "  ")
+	case atom.A:
+		text := strings.TrimSpace(inline(n))
+		if href := external(attr(n, "href")); href != "" && text != "" {
+			w.write(fmt.Sprintf("[%s](%s)", text, href))
+		} else {
+			w.write(text)
+		}
+	case atom.Img:
+		if src := external(attr(n, "src")); src != "" {
+			w.write(fmt.Sprintf("![%s](%s)", escape(attr(n, "alt")), src))
+		}
+	default:
+		w.children(n)
+	}
+}
+
+func (w *mdWriter) wrap(n *html.Node, mark string) {
+	text := strings.TrimSpace(inline(n))
+	if text != "" {
+		w.write(mark + text + mark)
+	}
+}
+
+func (w *mdWriter) list(n *html.Node) {
+	i := 0
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+			continue
+		}
+		i++
+
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d. ", i)
+		}
+
+		w.write("\n" + indent(render(c), marker, strings.Repeat(" ", len(marker))))
+	}
+}
+
+// inline renders the content of n on its own, for elements whose markdown
+// has to wrap their text.
+func inline(n *html.Node) string {
+	w := &mdWriter{}
+	w.children(n)
+	return strings.Join(strings.Fields(w.buf.String()), " ")
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// external returns href if it is an absolute http(s) URL, or an empty
+// string for links into the package.
+func external(href string) string {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
+
+var markdownSpecial = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`, "#", `\#`,
+)
+
+// escape keeps text from being read as markdown.
+func escape(s string) string {
+	return markdownSpecial.Replace(s)
+}
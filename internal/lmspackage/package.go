@@ -0,0 +1,511 @@
+// Package lmspackage reads courses out of zipped IMS Common Cartridge and
+// SCORM 1.2 packages. The manifest decides the structure: top-level items
+// become themes and the items below them lessons. Static HTML pages become
+// text lessons and web links link lessons; everything the service cannot
+// hold, like quizzes, discussions and hosted files, is skipped and
+// reported.
+package lmspackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
+)
+
+type Kind string
+
+const (
+	CommonCartridge Kind = "common_cartridge"
+	SCORM12         Kind = "scorm_1.2"
+)
+
+const (
+	// MaxSize is the largest package accepted, zipped.
+	MaxSize = 64 << 20
+	// maxFileSize caps how much of a single file is read and maxUnpacked
+	// how much of all of them, so a small package cannot unpack into
+	// something huge.
+	maxFileSize = 8 << 20
+	maxUnpacked = 128 << 20
+	// maxItems caps the items of the organization, lessons and modules
+	// alike.
+	maxItems = 5000
+
+	manifestName = "imsmanifest.xml"
+	maxTitleLen  = 255
+)
+
+var (
+	ErrInvalidPackage     = entities.NewError(entities.KindInvalidArgument, "PACKAGE_INVALID", "invalid course package")
+	ErrUnsupportedPackage = entities.NewError(entities.KindInvalidArgument, "PACKAGE_UNSUPPORTED", "only IMS Common Cartridge and SCORM 1.2 packages are supported")
+	ErrPackageTooLarge    = entities.NewError(entities.KindInvalidArgument, "PACKAGE_TOO_LARGE", "course package is too large")
+)
+
+// Skipped is an item of the package that did not become a lesson.
+type Skipped struct {
+	Identifier string
+	Title      string
+	Reason     string
+}
+
+// Defaults fills in what packages do not tell about a course. Description
+// is only used when the manifest has none.
+type Defaults struct {
+	Description    string
+	Difficulty     string
+	Image          string
+	LessonDuration int32
+}
+
+// Package is the result of Parse. The course has no description,
+// difficulty or image beyond what the manifest tells, and its lessons have
+// no duration; the caller fills them in.
+type Package struct {
+	Kind    Kind
+	Course  *entities.Course
+	Skipped []Skipped
+}
+
+type manifest struct {
+	Metadata struct {
+		Schema        string `xml:"schema"`
+		SchemaVersion string `xml:"schemaversion"`
+		LOM           struct {
+			General struct {
+				Title       langString `xml:"title"`
+				Description langString `xml:"description"`
+			} `xml:"general"`
+		} `xml:"lom"`
+	} `xml:"metadata"`
+	Organizations struct {
+		Default string         `xml:"default,attr"`
+		List    []organization `xml:"organization"`
+	} `xml:"organizations"`
+	Resources struct {
+		List []resource `xml:"resource"`
+	} `xml:"resources"`
+}
+
+// langString is a LOM text. Common Cartridge wraps it in string elements,
+// the SCORM 1.2 metadata in langstring elements.
+type langString struct {
+	Strings     []string `xml:"string"`
+	LangStrings []string `xml:"langstring"`
+}
+
+func (s langString) String() string {
+	for _, v := range append(s.Strings, s.LangStrings...) {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+type organization struct {
+	Identifier string `xml:"identifier,attr"`
+	Structure  string `xml:"structure,attr"`
+	Title      string `xml:"title"`
+	Items      []item `xml:"item"`
+}
+
+type item struct {
+	Identifier    string `xml:"identifier,attr"`
+	IdentifierRef string `xml:"identifierref,attr"`
+	IsVisible     string `xml:"isvisible,attr"`
+	Title         string `xml:"title"`
+	Items         []item `xml:"item"`
+}
+
+type resource struct {
+	Identifier string `xml:"identifier,attr"`
+	Type       string `xml:"type,attr"`
+	Href       string `xml:"href,attr"`
+	Files      []struct {
+		Href string `xml:"href,attr"`
+	} `xml:"file"`
+}
+
+type webLink struct {
+	Title string `xml:"title"`
+	URL   struct {
+		Href string `xml:"href,attr"`
+	} `xml:"url"`
+}
+
+// Parse reads the course out of a zipped package.
+func Parse(data []byte) (*Package, error) {
+	if len(data) > MaxSize {
+		return nil, ErrPackageTooLarge
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrInvalidPackage.WithField("data", err.Error())
+	}
+
+	p := &parser{files: make(map[string]*zip.File, len(zr.File))}
+	for _, f := range zr.File {
+		p.files[path.Clean(f.Name)] = f
+	}
+
+	raw, err := p.read(manifestName)
+	if err != nil {
+		if p.err != nil {
+			return nil, p.err
+		}
+		return nil, ErrInvalidPackage.WithField("data", err.Error())
+	}
+
+	var m manifest
+	if err := xml.Unmarshal(raw, &m); err != nil {
+		return nil, ErrInvalidPackage.WithField("data", manifestName+": "+err.Error())
+	}
+
+	kind, err := packageKind(&m)
+	if err != nil {
+		return nil, err
+	}
+
+	org := defaultOrganization(&m)
+	if org == nil {
+		return nil, ErrInvalidPackage.WithField("data", "the manifest has no organization")
+	}
+
+	if err := checkItems(org.Items, make(map[string]bool), new(int)); err != nil {
+		return nil, err
+	}
+
+	p.resources = make(map[string]*resource, len(m.Resources.List))
+	for i := range m.Resources.List {
+		p.resources[m.Resources.List[i].Identifier] = &m.Resources.List[i]
+	}
+
+	course := &entities.Course{
+		Title:       firstNonEmpty(org.Title, m.Metadata.LOM.General.Title.String(), org.Identifier),
+		Description: m.Metadata.LOM.General.Description.String(),
+	}
+	course.Title = truncate(course.Title)
+
+	items := org.Items
+	// Common Cartridge nests everything under a single untitled root item.
+	if org.Structure == "rooted-hierarchy" && len(items) == 1 && items[0].IdentifierRef == "" {
+		items = items[0].Items
+	}
+
+	// Lessons at the top level have no theme of their own. Consecutive
+	// ones are collected into a theme named after the course.
+	var loose *entities.Theme
+	for _, it := range items {
+		if len(it.Items) == 0 {
+			if loose == nil {
+				loose = &entities.Theme{Title: course.Title}
+				course.Themes = append(course.Themes, loose)
+			}
+			p.addLesson(loose, &it)
+			continue
+		}
+
+		loose = nil
+		theme := &entities.Theme{
+			Title:       truncate(firstNonEmpty(it.Title, it.Identifier)),
+			ExternalKey: it.Identifier,
+		}
+		if it.IdentifierRef != "" {
+			p.addLesson(theme, &it)
+		}
+		p.addLessons(theme, it.Items)
+		course.Themes = append(course.Themes, theme)
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	course.Themes = p.dropEmpty(course.Themes)
+	if len(course.Themes) == 0 {
+		return nil, ErrInvalidPackage.WithField("data", "the package has no importable lessons")
+	}
+
+	return &Package{
+		Kind:    kind,
+		Course:  course,
+		Skipped: p.skipped,
+	}, nil
+}
+
+func packageKind(m *manifest) (Kind, error) {
+	schema := strings.ToLower(strings.TrimSpace(m.Metadata.Schema))
+	version := strings.TrimSpace(m.Metadata.SchemaVersion)
+
+	switch {
+	case strings.Contains(schema, "common cartridge"):
+		return CommonCartridge, nil
+	case strings.Contains(schema, "scorm") && version == "1.2":
+		return SCORM12, nil
+	}
+	return "", ErrUnsupportedPackage.WithMetadata("schema", strings.TrimSpace(m.Metadata.Schema+" "+version))
+}
+
+func defaultOrganization(m *manifest) *organization {
+	orgs := m.Organizations.List
+	for i := range orgs {
+		if orgs[i].Identifier == m.Organizations.Default {
+			return &orgs[i]
+		}
+	}
+	if len(orgs) > 0 {
+		return &orgs[0]
+	}
+	return nil
+}
+
+// checkItems rejects organizations with more than maxItems items or with
+// an identifier used twice. Identifiers become the external keys of themes
+// and lessons, which are unique within a course.
+func checkItems(items []item, seen map[string]bool, count *int) error {
+	for i := range items {
+		it := &items[i]
+		*count++
+		if *count > maxItems {
+			return ErrPackageTooLarge.WithField("data", fmt.Sprintf("the package has more than %d items", maxItems))
+		}
+		if it.Identifier != "" {
+			if seen[it.Identifier] {
+				return ErrInvalidPackage.WithField("data", fmt.Sprintf("item identifier %s is used more than once", it.Identifier))
+			}
+			seen[it.Identifier] = true
+		}
+		if err := checkItems(it.Items, seen, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parser holds what the items of a package are turned into lessons with.
+// err is set once the files read add up to more than maxUnpacked, after
+// which nothing more is read.
+type parser struct {
+	files     map[string]*zip.File
+	resources map[string]*resource
+	skipped   []Skipped
+	unpacked  int
+	err       error
+}
+
+func (p *parser) skip(it *item, reason string, args ...any) {
+	p.skipped = append(p.skipped, Skipped{
+		Identifier: it.Identifier,
+		Title:      strings.TrimSpace(it.Title),
+		Reason:     fmt.Sprintf(reason, args...),
+	})
+}
+
+// addLessons adds the lessons of items and of every item below them to
+// theme, depth first. Deeper levels of the package are flattened.
+func (p *parser) addLessons(theme *entities.Theme, items []item) {
+	for i := range items {
+		it := &items[i]
+		if it.IdentifierRef != "" || len(it.Items) == 0 {
+			p.addLesson(theme, it)
+		}
+		p.addLessons(theme, it.Items)
+	}
+}
+
+func (p *parser) addLesson(theme *entities.Theme, it *item) {
+	if p.err != nil {
+		return
+	}
+	if strings.EqualFold(it.IsVisible, "false") {
+		p.skip(it, "the item is hidden")
+		return
+	}
+	if it.IdentifierRef == "" {
+		p.skip(it, "the item has no content")
+		return
+	}
+
+	res, ok := p.resources[it.IdentifierRef]
+	if !ok {
+		p.skip(it, "resource %s does not exist", it.IdentifierRef)
+		return
+	}
+
+	body, reason := p.lessonBody(res, strings.TrimSpace(it.Title))
+	if body == nil {
+		p.skip(it, "%s", reason)
+		return
+	}
+
+	lesson := &entities.Lesson{
+		Title:       truncate(firstNonEmpty(it.Title, it.Identifier)),
+		ExternalKey: it.Identifier,
+	}
+	lesson.SetBody(body)
+	// Lessons the course service would refuse are skipped here, so a
+	// single bad link does not fail the whole import.
+	if err := validation.Lesson(lesson, "title", "body"); err != nil {
+		p.skip(it, "%s", violations(err))
+		return
+	}
+	theme.Lessons = append(theme.Lessons, lesson)
+}
+
+// lessonBody converts a resource into a lesson body, or tells why it
+// cannot be converted.
+func (p *parser) lessonBody(res *resource, title string) (any, string) {
+	typ := strings.ToLower(res.Type)
+
+	switch {
+	case typ == "webcontent":
+		href := res.Href
+		if href == "" && len(res.Files) > 0 {
+			href = res.Files[0].Href
+		}
+		if href == "" {
+			return nil, "the resource has no file"
+		}
+
+		if u, err := url.Parse(href); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			return &entities.LinkBody{URL: href, Label: truncate(title)}, ""
+		}
+
+		name := filePath(href)
+		switch strings.ToLower(path.Ext(name)) {
+		case ".html", ".htm", ".xhtml":
+		default:
+			return nil, fmt.Sprintf("file %s is not a web page, files are not hosted by the course service", name)
+		}
+
+		page, err := p.read(name)
+		if err != nil {
+			return nil, err.Error()
+		}
+		md, err := htmlToMarkdown(page)
+		if err != nil {
+			return nil, fmt.Sprintf("%s: %s", name, err)
+		}
+		if md == "" {
+			return nil, fmt.Sprintf("%s has no static text, interactive content is not supported", name)
+		}
+		return &entities.TextBody{Markdown: md}, ""
+
+	case strings.HasPrefix(typ, "imswl_xmlv1p"):
+		if len(res.Files) == 0 {
+			return nil, "the web link has no file"
+		}
+
+		raw, err := p.read(filePath(res.Files[0].Href))
+		if err != nil {
+			return nil, err.Error()
+		}
+		var link webLink
+		if err := xml.Unmarshal(raw, &link); err != nil || link.URL.Href == "" {
+			return nil, "the web link has no url"
+		}
+		return &entities.LinkBody{URL: link.URL.Href, Label: truncate(firstNonEmpty(strings.TrimSpace(link.Title), title))}, ""
+	}
+
+	return nil, fmt.Sprintf("resource type %s is not supported", res.Type)
+}
+
+// read returns the content of the named file of the package.
+func (p *parser) read(name string) ([]byte, error) {
+	f, ok := p.files[name]
+	if !ok {
+		return nil, fmt.Errorf("file %s does not exist", name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	defer rc.Close()
+
+	limit := min(maxFileSize, maxUnpacked-p.unpacked)
+	data, err := io.ReadAll(io.LimitReader(rc, int64(limit)+1))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(data) > limit {
+		if limit < maxFileSize {
+			p.err = ErrPackageTooLarge.WithField("data", fmt.Sprintf("the package unpacks into more than %d bytes", maxUnpacked))
+			return nil, p.err
+		}
+		return nil, fmt.Errorf("file %s is too large", name)
+	}
+	p.unpacked += len(data)
+	return data, nil
+}
+
+// dropEmpty removes themes without lessons and reports them.
+func (p *parser) dropEmpty(themes []*entities.Theme) []*entities.Theme {
+	res := themes[:0]
+	for _, th := range themes {
+		if len(th.Lessons) == 0 {
+			p.skipped = append(p.skipped, Skipped{
+				Identifier: th.ExternalKey,
+				Title:      th.Title,
+				Reason:     "the module has no importable lessons",
+			})
+			continue
+		}
+		res = append(res, th)
+	}
+	return res
+}
+
+// filePath turns an href of the manifest into the name of a file in the
+// package.
+func filePath(href string) string {
+	if i := strings.IndexAny(href, "?#"); i >= 0 {
+		href = href[:i]
+	}
+	if unescaped, err := url.PathUnescape(href); err == nil {
+		href = unescaped
+	}
+	return path.Clean(strings.TrimPrefix(href, "/"))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// violations joins the field violations of a validation error into a
+// reason for the skipped report.
+func violations(err error) string {
+	var e *entities.Error
+	if !errors.As(err, &e) || len(e.Violations) == 0 {
+		return err.Error()
+	}
+
+	reasons := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		reasons[i] = v.Field + " " + v.Description
+	}
+	return strings.Join(reasons, "; ")
+}
+
+func truncate(s string) string {
+	if utf8.RuneCountInString(s) <= maxTitleLen {
+		return s
+	}
+	return string([]rune(s)[:maxTitleLen])
+}
@@ -0,0 +1,194 @@
+package lmspackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const scormMetadata = `<metadata><schema>ADL SCORM</schema><schemaversion>1.2</schemaversion></metadata>`
+
+// zipOf packs files, name to content, into a zip archive.
+func zipOf(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// manifestOf builds a SCORM 1.2 manifest with a single organization of
+// items and one web page resource per page.
+func manifestOf(items string, pages ...string) string {
+	var resources strings.Builder
+	for i, page := range pages {
+		fmt.Fprintf(&resources, `<resource identifier="r%d" type="webcontent" href="%s"/>`, i, page)
+	}
+	return `<manifest>` + scormMetadata +
+		`<organizations default="org"><organization identifier="org"><title>Course</title>` + items +
+		`</organization></organizations><resources>` + resources.String() + `</resources></manifest>`
+}
+
+func TestParseRejects(t *testing.T) {
+	manyItems := strings.Repeat(`<item identifierref="r0"><title>x</title></item>`, maxItems+1)
+
+	bigPages := make(map[string]string)
+	var bigItems strings.Builder
+	var bigNames []string
+	for i := range maxUnpacked/maxFileSize + 1 {
+		name := fmt.Sprintf("page%d.html", i)
+		bigPages[name] = strings.Repeat(" ", maxFileSize)
+		bigNames = append(bigNames, name)
+		fmt.Fprintf(&bigItems, `<item identifier="i%d" identifierref="r%d"><title>x</title></item>`, i, i)
+	}
+	bigPages[manifestName] = manifestOf(bigItems.String(), bigNames...)
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{
+			name: "not a zip",
+			data: []byte("not a zip"),
+			want: ErrInvalidPackage,
+		},
+		{
+			name: "too large",
+			data: make([]byte, MaxSize+1),
+			want: ErrPackageTooLarge,
+		},
+		{
+			name: "no manifest",
+			data: zipOf(t, map[string]string{"index.html": "<p>hi</p>"}),
+			want: ErrInvalidPackage,
+		},
+		{
+			name: "broken manifest",
+			data: zipOf(t, map[string]string{manifestName: "<manifest><organizations>"}),
+			want: ErrInvalidPackage,
+		},
+		{
+			name: "unknown schema",
+			data: zipOf(t, map[string]string{manifestName: `<manifest><metadata><schema>ADL SCORM</schema><schemaversion>2004 4th Edition</schemaversion></metadata></manifest>`}),
+			want: ErrUnsupportedPackage,
+		},
+		{
+			name: "no organization",
+			data: zipOf(t, map[string]string{manifestName: `<manifest>` + scormMetadata + `</manifest>`}),
+			want: ErrInvalidPackage,
+		},
+		{
+			name: "repeated item identifier",
+			data: zipOf(t, map[string]string{
+				manifestName: manifestOf(`<item identifier="i"><title>a</title>`+
+					`<item identifier="i" identifierref="r0"><title>b</title></item></item>`, "index.html"),
+				"index.html": "<p>hi</p>",
+			}),
+			want: ErrInvalidPackage,
+		},
+		{
+			name: "too many items",
+			data: zipOf(t, map[string]string{
+				manifestName: manifestOf(manyItems, "index.html"),
+				"index.html": "<p>hi</p>",
+			}),
+			want: ErrPackageTooLarge,
+		},
+		{
+			name: "unpacks too large",
+			data: zipOf(t, bigPages),
+			want: ErrPackageTooLarge,
+		},
+		{
+			name: "no importable lessons",
+			data: zipOf(t, map[string]string{
+				manifestName: manifestOf(`<item identifier="i" identifierref="r0"><title>a</title></item>`, "quiz.swf"),
+			}),
+			want: ErrInvalidPackage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg, err := Parse(tt.data)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Parse() = %+v, %v, want error %v", pkg, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	data := zipOf(t, map[string]string{
+		manifestName: manifestOf(
+			`<item identifier="m"><title>Module</title>`+
+				`<item identifier="l1" identifierref="r0"><title>Page</title></item>`+
+				`<item identifier="l2" identifierref="r1"><title>Movie</title></item></item>`,
+			"page.html", "movie.swf"),
+		"page.html": "<h1>Hello</h1><p>world</p>",
+	})
+
+	pkg, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if pkg.Kind != SCORM12 || pkg.Course.Title != "Course" || len(pkg.Course.Themes) != 1 {
+		t.Fatalf("Parse() = %+v, want a SCORM course with one theme", pkg.Course)
+	}
+	theme := pkg.Course.Themes[0]
+	if theme.ExternalKey != "m" || len(theme.Lessons) != 1 || theme.Lessons[0].ExternalKey != "l1" {
+		t.Errorf("Parse() theme = %+v, want module m with lesson l1", theme)
+	}
+	if len(pkg.Skipped) != 1 || pkg.Skipped[0].Identifier != "l2" {
+		t.Errorf("Parse() skipped = %+v, want l2", pkg.Skipped)
+	}
+}
+
+func TestParseSkipsInvalidLinks(t *testing.T) {
+	manifest := `<manifest>` + scormMetadata +
+		`<organizations><organization identifier="org"><title>Course</title>` +
+		`<item identifier="ok" identifierref="r0"><title>Site</title></item>` +
+		`<item identifier="mail" identifierref="r1"><title>Mail</title></item>` +
+		`</organization></organizations><resources>` +
+		`<resource identifier="r0" type="imswl_xmlv1p1"><file href="site.xml"/></resource>` +
+		`<resource identifier="r1" type="imswl_xmlv1p1"><file href="mail.xml"/></resource>` +
+		`</resources></manifest>`
+	data := zipOf(t, map[string]string{
+		manifestName: manifest,
+		"site.xml":   `<webLink><title>` + strings.Repeat("x", 300) + `</title><url href="https://example.com"/></webLink>`,
+		"mail.xml":   `<webLink><title>Mail</title><url href="mailto:team@example.com"/></webLink>`,
+	})
+
+	pkg, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	lessons := pkg.Course.Themes[0].Lessons
+	if len(lessons) != 1 || lessons[0].ExternalKey != "ok" {
+		t.Fatalf("Parse() lessons = %+v, want only ok", lessons)
+	}
+	if got := len([]rune(lessons[0].Link.Label)); got != maxTitleLen {
+		t.Errorf("Parse() label length = %d, want %d", got, maxTitleLen)
+	}
+	if len(pkg.Skipped) != 1 || pkg.Skipped[0].Identifier != "mail" || !strings.Contains(pkg.Skipped[0].Reason, "link.url") {
+		t.Errorf("Parse() skipped = %+v, want mail with a link.url reason", pkg.Skipped)
+	}
+}
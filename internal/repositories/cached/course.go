@@ -0,0 +1,112 @@
+// Package cached puts a read-through cache in front of the course
+// repository.
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/cache"
+)
+
+const (
+	treeKeyPrefix = "course:tree:"
+	listKeyPrefix = "course:list:"
+	genKeyPrefix  = "course:gen:"
+	listGenKey    = genKeyPrefix + "list"
+)
+
+// CourseRepository caches course trees and the course catalog of the
+// wrapped repository. Everything else, including reads and writes inside
+// WithTx, goes straight to the wrapped repository; writers call Invalidate
+// once their changes are committed.
+//
+// Cached values are keyed by the generation of what they were loaded from,
+// the course or the catalog, and Invalidate moves that to a new
+// generation. A reader that loaded a value before a write and stores it
+// after the invalidation stores it under the old generation, where nobody
+// reads it anymore.
+type CourseRepository struct {
+	services.CourseRepo
+	store cache.Store
+	ttl   time.Duration
+}
+
+func NewCourseRepository(repo services.CourseRepo, store cache.Store, ttl time.Duration) *CourseRepository {
+	return &CourseRepository{
+		CourseRepo: repo,
+		store:      store,
+		ttl:        ttl,
+	}
+}
+
+func (r *CourseRepository) GetCourseTree(ctx context.Context, id int) (*entities.Course, error) {
+	return readThrough(ctx, r, genKeyPrefix+strconv.Itoa(id), treeKeyPrefix+strconv.Itoa(id), func() (*entities.Course, error) {
+		return r.CourseRepo.GetCourseTree(ctx, id)
+	})
+}
+
+func (r *CourseRepository) GetAllCourses(ctx context.Context, statuses ...entities.CourseStatus) ([]*entities.Course, error) {
+	key := make([]string, len(statuses))
+	for i, st := range statuses {
+		key[i] = string(st)
+	}
+
+	return readThrough(ctx, r, listGenKey, listKeyPrefix+strings.Join(key, ","), func() ([]*entities.Course, error) {
+		return r.CourseRepo.GetAllCourses(ctx, statuses...)
+	})
+}
+
+// Invalidate moves the given courses and the whole catalog, which lists
+// every course, to new generations. Entries of the old ones are left to
+// expire.
+func (r *CourseRepository) Invalidate(ctx context.Context, cids ...int) error {
+	gen := []byte(strconv.FormatInt(time.Now().UnixNano(), 36))
+
+	// A generation outlives the entries stored under the one before it, so
+	// none of them is read again once it expires and readers start over.
+	errs := make([]error, 0, len(cids)+1)
+	for _, id := range cids {
+		errs = append(errs, r.store.Set(ctx, genKeyPrefix+strconv.Itoa(id), gen, 2*r.ttl))
+	}
+	errs = append(errs, r.store.Set(ctx, listGenKey, gen, 2*r.ttl))
+
+	return errors.Join(errs...)
+}
+
+// readThrough returns the value of key cached under the current generation
+// of genKey, or loads and caches it. A failing cache is bypassed rather
+// than failing the read; the store metrics count the errors.
+func readThrough[T any](ctx context.Context, r *CourseRepository, genKey, key string, load func() (T, error)) (T, error) {
+	gen, ok, err := r.store.Get(ctx, genKey)
+	if err != nil {
+		return load()
+	}
+	if ok {
+		key += "@" + string(gen)
+	}
+
+	if data, ok, err := r.store.Get(ctx, key); err == nil && ok {
+		var v T
+		if err := json.Unmarshal(data, &v); err == nil {
+			return v, nil
+		}
+	}
+
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+
+	if data, err := json.Marshal(v); err == nil {
+		_ = r.store.Set(ctx, key, data, r.ttl)
+	}
+
+	return v, nil
+}
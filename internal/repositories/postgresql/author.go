@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// AddCoAuthor adds a user to the course or changes the role of one that is
+// already there. The owner's role is never changed.
+func (r *CourseRepository) AddCoAuthor(ctx context.Context, obj *entities.CoAuthor) error {
+	const op = "repositories.CourseRepository.AddCoAuthor"
+
+	row := r.db.QueryRow(
+		ctx,
+		`INSERT INTO course_authors(course_id, user_id, role) VALUES ($1, $2, $3)
+		 ON CONFLICT (course_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		 WHERE course_authors.role <> 'owner'
+		 RETURNING added_at`,
+		obj.CourseID, obj.UserID, string(obj.Role))
+
+	err := row.Scan(&obj.AddedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return services.ErrOwnerRoleLocked
+		}
+		if postgres.ErrCode(err) == postgres.ForeignKeyViolation {
+			return services.ErrCourseNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) RemoveCoAuthor(ctx context.Context, cid, uid int) error {
+	const op = "repositories.CourseRepository.RemoveCoAuthor"
+
+	tag, err := r.db.Exec(ctx, "DELETE FROM course_authors WHERE course_id=$1 AND user_id=$2", cid, uid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return services.ErrCoAuthorNotFound
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) GetCoAuthors(ctx context.Context, cid int) ([]*entities.CoAuthor, error) {
+	const op = "repositories.CourseRepository.GetCoAuthors"
+	arraySize := 5
+	rows, err := r.db.Query(ctx,
+		"SELECT course_id, user_id, role, added_at FROM course_authors WHERE course_id=$1 ORDER BY added_at, user_id",
+		cid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	authors := make([]*entities.CoAuthor, 0, arraySize)
+	for rows.Next() {
+		var obj entities.CoAuthor
+		if err := rows.Scan(&obj.CourseID, &obj.UserID, &obj.Role, &obj.AddedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		authors = append(authors, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return authors, nil
+}
+
+// GetAuthorRole returns the role of a user in the course, or an empty role
+// if the user does not work on it. Soft-deleted courses are included, so
+// they can be restored by their authors.
+func (r *CourseRepository) GetAuthorRole(ctx context.Context, cid, uid int) (entities.AuthorRole, error) {
+	const op = "repositories.CourseRepository.GetAuthorRole"
+
+	var role entities.AuthorRole
+	err := r.db.QueryRow(ctx,
+		`SELECT COALESCE(a.role, '') FROM course c
+		 LEFT JOIN course_authors a ON a.course_id = c.id AND a.user_id = $2
+		 WHERE c.id = $1`,
+		cid, uid).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", services.ErrCourseNotFound
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return role, nil
+}
+
+// GetAuthorCourses returns the live courses the user works on, in any role.
+func (r *CourseRepository) GetAuthorCourses(ctx context.Context, uid int) ([]*entities.Course, error) {
+	const op = "repositories.CourseRepository.GetAuthorCourses"
+	arraySize := 20
+	rows, err := r.db.Query(ctx,
+		"SELECT "+courseColumns+" FROM course WHERE "+liveCourse+
+			" AND id IN (SELECT course_id FROM course_authors WHERE user_id=$1) ORDER BY id",
+		uid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	courses := make([]*entities.Course, 0, arraySize)
+	for rows.Next() {
+		var obj entities.Course
+		if err := scanCourse(rows, &obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		courses = append(courses, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return courses, nil
+}
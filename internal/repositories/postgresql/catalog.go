@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+)
+
+var sortColumns = map[entities.CourseSortField]string{
+	entities.SortByCreatedAt: "created_at",
+	entities.SortByTitle:     "title",
+	entities.SortByDuration:  "duration",
+}
+
+// courseCursor is the keyset position after the last course of a page. The
+// sort settings are stored too, so a token cannot be replayed with another
+// ordering.
+type courseCursor struct {
+	SortBy entities.CourseSortField `json:"s"`
+	Desc   bool                     `json:"d"`
+	Value  string                   `json:"v"`
+	ID     int                      `json:"id"`
+}
+
+func encodeCursor(params entities.CourseListParams, last *entities.Course) string {
+	cur := courseCursor{SortBy: params.SortBy, Desc: params.Desc, ID: last.ID}
+	switch params.SortBy {
+	case entities.SortByTitle:
+		cur.Value = last.Title
+	case entities.SortByDuration:
+		cur.Value = strconv.Itoa(int(last.Duration))
+	default:
+		cur.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	b, _ := json.Marshal(cur)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor returns the sort key and id stored in token.
+func decodeCursor(token string, params entities.CourseListParams) (any, int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, 0, services.ErrInvalidPageToken
+	}
+
+	var cur courseCursor
+	if err := json.Unmarshal(b, &cur); err != nil {
+		return nil, 0, services.ErrInvalidPageToken
+	}
+	if cur.SortBy != params.SortBy || cur.Desc != params.Desc {
+		return nil, 0, services.ErrInvalidPageToken
+	}
+
+	switch cur.SortBy {
+	case entities.SortByTitle:
+		return cur.Value, cur.ID, nil
+	case entities.SortByDuration:
+		n, err := strconv.Atoi(cur.Value)
+		if err != nil {
+			return nil, 0, services.ErrInvalidPageToken
+		}
+		return int32(n), cur.ID, nil
+	default:
+		t, err := time.Parse(time.RFC3339Nano, cur.Value)
+		if err != nil {
+			return nil, 0, services.ErrInvalidPageToken
+		}
+		return t, cur.ID, nil
+	}
+}
+
+func (r *CourseRepository) ListCourses(ctx context.Context, params entities.CourseListParams) (*entities.CoursePage, error) {
+	const op = "repositories.CourseRepository.ListCourses"
+
+	column, ok := sortColumns[params.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown sort field %q", op, params.SortBy)
+	}
+
+	var (
+		where = []string{liveCourse}
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(params.Statuses) > 0 {
+		where = append(where, "status = ANY("+arg(statusStrings(params.Statuses))+")")
+	}
+	if len(params.Difficulties) > 0 {
+		where = append(where, "difficulty = ANY("+arg(params.Difficulties)+")")
+	}
+	if params.MinDuration > 0 {
+		where = append(where, "duration >= "+arg(params.MinDuration))
+	}
+	if params.MaxDuration > 0 {
+		where = append(where, "duration <= "+arg(params.MaxDuration))
+	}
+
+	page := &entities.CoursePage{}
+	err := r.db.QueryRow(ctx, "SELECT count(*) FROM course"+whereClause(where), args...).Scan(&page.Total)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	order, cmp := "ASC", ">"
+	if params.Desc {
+		order, cmp = "DESC", "<"
+	}
+
+	if params.Cursor != "" {
+		value, id, err := decodeCursor(params.Cursor, params)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, fmt.Sprintf("(%s, id) %s (%s, %s)", column, cmp, arg(value), arg(id)))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM course%s ORDER BY %s %s, id %s LIMIT %s",
+		courseColumns, whereClause(where), column, order, order, arg(params.Limit+1))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	page.Courses = make([]*entities.Course, 0, params.Limit+1)
+	for rows.Next() {
+		var obj entities.Course
+		if err := scanCourse(rows, &obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		page.Courses = append(page.Courses, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(page.Courses) > params.Limit {
+		page.Courses = page.Courses[:params.Limit]
+		page.NextCursor = encodeCursor(params, page.Courses[len(page.Courses)-1])
+	}
+
+	return page, nil
+}
+
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
@@ -2,33 +2,52 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/jackc/pgx/v5"
 )
 
 type CourseRepository struct {
 	*postgres.Postgres
+	db postgres.DBTX
 }
 
 func NewCourseRepository(pg *postgres.Postgres) *CourseRepository {
-	return &CourseRepository{pg}
+	return &CourseRepository{Postgres: pg, db: pg.Pool}
+}
+
+// WithTx runs fn against a copy of the repository bound to a single
+// transaction. Everything fn does is committed together or not at all.
+func (r *CourseRepository) WithTx(ctx context.Context, fn func(repo services.CourseRepo) error) error {
+	const op = "repositories.CourseRepository.WithTx"
+
+	err := postgres.InTx(ctx, r.db, func(tx pgx.Tx) error {
+		return fn(&CourseRepository{Postgres: r.Postgres, db: tx})
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
 }
 
 func (r *CourseRepository) Create(ctx context.Context, obj *entities.Course) (id int, err error) {
 	const op = "repositories.CourseRepository.Create"
 
-	row := r.Pool.QueryRow(
+	row := r.db.QueryRow(
 		ctx,
-		"INSERT INTO course(title, description, full_descritpion, work, difficulty, duration, image) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id",
-		obj.Title, obj.Description, obj.FullDescription, obj.Work, obj.Difficulty, obj.Duration, obj.Image)
+		"INSERT INTO course(title, description, full_descritpion, work, difficulty, duration, duration_override, image, status, owner_id, external_key) VALUES ($1, $2, $3, $4, $5, COALESCE($6, 0), $6, $7, $8, NULLIF($9, 0), "+externalKey(10)+") RETURNING id",
+		obj.Title, obj.Description, obj.FullDescription, obj.Work, obj.Difficulty, obj.DurationOverride, obj.Image, string(obj.Status), obj.OwnerID, obj.ExternalKey)
 
 	err = row.Scan(&id)
 	if err != nil {
-		if strings.Contains(err.Error(), "SQLSTATE 23505") {
+		if postgres.ErrCode(err) == postgres.UniqueViolation {
 			return -1, services.ErrCourseAlreadyExists
 		}
 		return -1, fmt.Errorf("%s: %w", op, err)
@@ -40,15 +59,18 @@ func (r *CourseRepository) Create(ctx context.Context, obj *entities.Course) (id
 func (r *CourseRepository) CreateTheme(ctx context.Context, obj *entities.Theme) (id int, err error) {
 	const op = "repositories.CourseRepository.CreateTheme"
 
-	row := r.Pool.QueryRow(
+	row := r.db.QueryRow(
 		ctx,
-		"INSERT INTO theme(course_id, title) VALUES ($1, $2) RETURNING id",
-		obj.CourseID, obj.Title)
+		"INSERT INTO theme(course_id, title, duration, duration_override, position, external_key) VALUES ($1, $2, COALESCE($3, 0), $3, $4, "+externalKey(5)+") RETURNING id",
+		obj.CourseID, obj.Title, obj.DurationOverride, obj.Position, obj.ExternalKey)
 
 	err = row.Scan(&id)
 	if err != nil {
-		if strings.Contains(err.Error(), "SQLSTATE 23505") {
-			return -1, services.ErrCourseAlreadyExists
+		switch postgres.ErrCode(err) {
+		case postgres.UniqueViolation:
+			return -1, services.ErrThemeAlreadyExists
+		case postgres.ForeignKeyViolation:
+			return -1, services.ErrCourseNotFound
 		}
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
@@ -59,57 +81,205 @@ func (r *CourseRepository) CreateTheme(ctx context.Context, obj *entities.Theme)
 func (r *CourseRepository) CreateLesson(ctx context.Context, obj *entities.Lesson) (id int, err error) {
 	const op = "repositories.CourseRepository.CreateLesson"
 
-	row := r.Pool.QueryRow(
+	row := r.db.QueryRow(
 		ctx,
-		"INSERT INTO lesson(course_id, theme_id, title, type, duration, content, task) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id",
-		obj.CourseID, obj.ThemeID, obj.Title, obj.Type, obj.Duration, obj.Content, obj.Task)
+		"INSERT INTO lesson(course_id, theme_id, title, kind, duration, body, task, position, external_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, "+externalKey(9)+") RETURNING id",
+		obj.CourseID, obj.ThemeID, obj.Title, string(obj.Kind), obj.Duration, obj.Body(), obj.Task, obj.Position, obj.ExternalKey)
 
 	err = row.Scan(&id)
 	if err != nil {
-		if strings.Contains(err.Error(), "SQLSTATE 23505") {
-			return -1, services.ErrCourseAlreadyExists
+		switch postgres.ErrCode(err) {
+		case postgres.UniqueViolation:
+			return -1, services.ErrLessonAlreadyExists
+		case postgres.ForeignKeyViolation:
+			return -1, services.ErrThemeNotFound
 		}
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := r.updateDurations(ctx, obj.CourseID); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return id, nil
 }
 
-func (r *CourseRepository) GetAllCourses(ctx context.Context) ([]*entities.Course, error) {
+const courseColumns = "id, title, description, full_descritpion, work, difficulty, duration, image, status, created_at, deleted_at, COALESCE(owner_id, 0), computed_duration, duration_override, external_key"
+
+// externalKey is the insert value of an external_key column: the key bound
+// to parameter n, or a new one when it is empty.
+func externalKey(n int) string {
+	return fmt.Sprintf("COALESCE(NULLIF($%d, ''), gen_random_uuid()::text)", n)
+}
+
+// Soft-deleted courses, and the themes and lessons inside them, are
+// invisible to every query except the ones that restore or purge them.
+const (
+	liveCourse   = "deleted_at IS NULL"
+	inLiveCourse = "course_id IN (SELECT id FROM course WHERE deleted_at IS NULL)"
+)
+
+func scanCourse(row pgx.Row, obj *entities.Course) error {
+	return row.Scan(&obj.ID, &obj.Title, &obj.Description, &obj.FullDescription,
+		&obj.Work, &obj.Difficulty, &obj.Duration, &obj.Image, &obj.Status, &obj.CreatedAt, &obj.DeletedAt, &obj.OwnerID,
+		&obj.ComputedDuration, &obj.DurationOverride, &obj.ExternalKey)
+}
+
+// statusStrings converts statuses into a query argument. An empty list
+// becomes NULL, which the queries treat as "any status".
+func statusStrings[S ~string](statuses []S) []string {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(statuses))
+	for i, st := range statuses {
+		res[i] = string(st)
+	}
+	return res
+}
+
+// GetAllCourses returns every course with one of statuses, or every course
+// when no status is given.
+func (r *CourseRepository) GetAllCourses(ctx context.Context, statuses ...entities.CourseStatus) ([]*entities.Course, error) {
 	const op = "repositories.CourseRepository.GetAllCourses"
 	arraySize := 20
-	rows, err := r.Pool.Query(ctx, "SELECT * FROM course")
+	rows, err := r.db.Query(ctx,
+		"SELECT "+courseColumns+" FROM course WHERE "+liveCourse+" AND ($1::text[] IS NULL OR status = ANY($1::text[])) ORDER BY id",
+		statusStrings(statuses))
 
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
 	courses := make([]*entities.Course, 0, arraySize)
 	for rows.Next() {
 		var obj entities.Course
-		err := rows.Scan(&obj.ID, &obj.Title, &obj.Description, &obj.FullDescription,
-			&obj.Work, &obj.Difficulty, &obj.Duration, &obj.Image)
+		err := scanCourse(rows, &obj)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		courses = append(courses, &obj)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return courses, nil
 }
 
+// GetCourseIDByKey returns the id of the live course with the given
+// external key.
+func (r *CourseRepository) GetCourseIDByKey(ctx context.Context, key string) (id int, err error) {
+	const op = "repositories.CourseRepository.GetCourseIDByKey"
+
+	err = r.db.QueryRow(ctx,
+		"SELECT id FROM course WHERE external_key=$1 AND "+liveCourse,
+		key).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return -1, services.ErrCourseNotFound
+		}
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
 func (r *CourseRepository) GetCourse(ctx context.Context, id int) (*entities.Course, error) {
 	const op = "repositories.CourseRepository.GetCourse"
 
-	row := r.Pool.QueryRow(
+	row := r.db.QueryRow(
 		ctx,
-		"SELECT id, title, description, full_descritpion, work, difficulty, duration, image FROM course WHERE id=$1",
+		"SELECT "+courseColumns+" FROM course WHERE id=$1 AND "+liveCourse,
 		id)
 
 	var obj entities.Course
-	err := row.Scan(&obj.ID, &obj.Title, &obj.Description, &obj.FullDescription,
-		&obj.Work, &obj.Difficulty, &obj.Duration, &obj.Image)
+	err := scanCourse(row, &obj)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrCourseNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &obj, nil
+}
+
+const (
+	themeColumns  = "id, course_id, title, duration, computed_duration, duration_override, position, external_key"
+	lessonColumns = "id, course_id, theme_id, title, kind, duration, body, task, position, external_key"
+)
+
+func scanTheme(row pgx.Row, obj *entities.Theme) error {
+	return row.Scan(&obj.ID, &obj.CourseID, &obj.Title,
+		&obj.Duration, &obj.ComputedDuration, &obj.DurationOverride, &obj.Position, &obj.ExternalKey)
+}
+
+func scanLesson(row pgx.Row, obj *entities.Lesson) error {
+	var (
+		kind string
+		body []byte
+	)
+	err := row.Scan(&obj.ID, &obj.CourseID, &obj.ThemeID, &obj.Title,
+		&kind, &obj.Duration, &body, &obj.Task, &obj.Position, &obj.ExternalKey)
+	if err != nil {
+		return err
+	}
+
+	return setLessonBody(obj, entities.LessonKind(kind), body)
+}
+
+// setLessonBody decodes the kind specific body of a lesson, which is stored
+// as JSON.
+func setLessonBody(obj *entities.Lesson, kind entities.LessonKind, body []byte) error {
+	b := entities.NewLessonBody(kind)
+	if b == nil {
+		return fmt.Errorf("unknown lesson kind %q", kind)
+	}
+	if err := json.Unmarshal(body, b); err != nil {
+		return err
+	}
+	obj.SetBody(b)
+	return nil
+}
+
+func (r *CourseRepository) GetTheme(ctx context.Context, id int) (*entities.Theme, error) {
+	const op = "repositories.CourseRepository.GetTheme"
+
+	row := r.db.QueryRow(
+		ctx,
+		"SELECT "+themeColumns+" FROM theme WHERE id=$1 AND "+inLiveCourse,
+		id)
+
+	var obj entities.Theme
+	err := scanTheme(row, &obj)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrThemeNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &obj, nil
+}
+
+func (r *CourseRepository) GetLesson(ctx context.Context, id int) (*entities.Lesson, error) {
+	const op = "repositories.CourseRepository.GetLesson"
+
+	row := r.db.QueryRow(
+		ctx,
+		"SELECT "+lessonColumns+" FROM lesson WHERE id=$1 AND "+inLiveCourse,
+		id)
+
+	var obj entities.Lesson
+	err := scanLesson(row, &obj)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrLessonNotFound
+		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -119,106 +289,482 @@ func (r *CourseRepository) GetCourse(ctx context.Context, id int) (*entities.Cou
 func (r *CourseRepository) GetThemes(ctx context.Context, cid int) ([]*entities.Theme, error) {
 	const op = "repositories.CourseRepository.GetThemes"
 	arraySize := 20
-	rows, err := r.Pool.Query(ctx, "SELECT * FROM theme WHERE course_id=$1", cid)
+	rows, err := r.db.Query(ctx, "SELECT "+themeColumns+" FROM theme WHERE course_id=$1 ORDER BY position, id", cid)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
 	themes := make([]*entities.Theme, 0, arraySize)
 	for rows.Next() {
 		var obj entities.Theme
-		err := rows.Scan(&obj.ID, &obj.CourseID, &obj.Title)
+		err := scanTheme(rows, &obj)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		themes = append(themes, &obj)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return themes, nil
 }
 
 func (r *CourseRepository) GetLessons(ctx context.Context, cid, tid int) ([]*entities.Lesson, error) {
 	const op = "repositories.CourseRepository.GetLessons"
 	arraySize := 20
-	rows, err := r.Pool.Query(ctx, "SELECT * FROM lesson WHERE course_id=$1 AND theme_id=$2", cid, tid)
+	rows, err := r.db.Query(ctx, "SELECT "+lessonColumns+" FROM lesson WHERE course_id=$1 AND theme_id=$2 ORDER BY position, id", cid, tid)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
 	lessons := make([]*entities.Lesson, 0, arraySize)
 	for rows.Next() {
 		var obj entities.Lesson
-		err := rows.Scan(&obj.ID, &obj.CourseID, &obj.ThemeID, &obj.Title,
-			&obj.Type, &obj.Duration, &obj.Content, &obj.Task)
+		err := scanLesson(rows, &obj)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		lessons = append(lessons, &obj)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lessons, nil
+}
+
+// GetUnrenderedLessons returns text lessons with an id above after whose
+// body was stored without rendered HTML, in id order.
+func (r *CourseRepository) GetUnrenderedLessons(ctx context.Context, after, limit int) ([]*entities.Lesson, error) {
+	const op = "repositories.CourseRepository.GetUnrenderedLessons"
+
+	rows, err := r.db.Query(ctx,
+		"SELECT "+lessonColumns+" FROM lesson WHERE kind=$1 AND NOT body ? 'html' AND id > $2 ORDER BY id LIMIT $3",
+		string(entities.LessonText), after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	lessons := make([]*entities.Lesson, 0, limit)
+	for rows.Next() {
+		var obj entities.Lesson
+		if err := scanLesson(rows, &obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		lessons = append(lessons, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return lessons, nil
 }
 
+// SetLessonBody writes only the body of a lesson. Unlike UpdateLesson it
+// changes nothing the durations depend on.
+func (r *CourseRepository) SetLessonBody(ctx context.Context, obj *entities.Lesson) error {
+	const op = "repositories.CourseRepository.SetLessonBody"
+
+	tag, err := r.db.Exec(ctx, "UPDATE lesson SET body=$2 WHERE id=$1", obj.ID, obj.Body())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return services.ErrLessonNotFound
+	}
+
+	return nil
+}
+
+// GetCourseTree returns a course with its themes and their lessons, all in
+// position order. The three queries are sent as one batch, so the whole
+// tree costs a single round trip however many themes the course has.
+func (r *CourseRepository) GetCourseTree(ctx context.Context, id int) (*entities.Course, error) {
+	const op = "repositories.CourseRepository.GetCourseTree"
+
+	batch := &pgx.Batch{}
+	batch.Queue("SELECT "+courseColumns+" FROM course WHERE id=$1 AND "+liveCourse, id)
+	batch.Queue("SELECT "+themeColumns+" FROM theme WHERE course_id=$1 ORDER BY position, id", id)
+	batch.Queue("SELECT "+lessonColumns+" FROM lesson WHERE course_id=$1 ORDER BY position, id", id)
+
+	res := r.db.SendBatch(ctx, batch)
+	defer res.Close()
+
+	var course entities.Course
+	if err := scanCourse(res.QueryRow(), &course); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrCourseNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.Query()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	byID := make(map[int]*entities.Theme)
+	for rows.Next() {
+		var obj entities.Theme
+		if err := scanTheme(rows, &obj); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		course.Themes = append(course.Themes, &obj)
+		byID[obj.ID] = &obj
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err = res.Query()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var obj entities.Lesson
+		if err := scanLesson(rows, &obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if theme, ok := byID[obj.ThemeID]; ok {
+			theme.Lessons = append(theme.Lessons, &obj)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &course, nil
+}
+
+// DeleteCourse soft-deletes a course. It stays restorable until it is
+// purged.
 func (r *CourseRepository) DeleteCourse(ctx context.Context, id int) (err error) {
 	const op = "repositories.CourseRepository.DeleteCourse"
 
-	_, err = r.Pool.Exec(ctx,
-		"DELETE FROM course WHERE id=$1", id)
+	tag, err := r.db.Exec(ctx,
+		"UPDATE course SET deleted_at=now() WHERE id=$1 AND "+liveCourse, id)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return services.ErrCourseNotFound
+	}
+
 	return nil
 }
 
-func (r *CourseRepository) UpdateCourse(ctx context.Context, obj *entities.Course) (id int, err error) {
+func (r *CourseRepository) DeleteTheme(ctx context.Context, id int) (err error) {
+	const op = "repositories.CourseRepository.DeleteTheme"
+
+	var cid int
+	err = r.db.QueryRow(ctx,
+		"DELETE FROM theme WHERE id=$1 AND "+inLiveCourse+" RETURNING course_id", id).Scan(&cid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return services.ErrThemeNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.updateDurations(ctx, cid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) DeleteLesson(ctx context.Context, id int) (err error) {
+	const op = "repositories.CourseRepository.DeleteLesson"
+
+	var cid int
+	err = r.db.QueryRow(ctx,
+		"DELETE FROM lesson WHERE id=$1 AND "+inLiveCourse+" RETURNING course_id", id).Scan(&cid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return services.ErrLessonNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.updateDurations(ctx, cid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UpdateCourse writes the given fields of obj, or all of them when fields is
+// empty.
+func (r *CourseRepository) UpdateCourse(ctx context.Context, obj *entities.Course, fields ...string) (id int, err error) {
 	const op = "repositories.CourseRepository.UpdateCourse"
 
-	row := r.Pool.QueryRow(
-		ctx,
-		(`UPDATE course SET title=$1, description=$2, full_descritpion=$3, work=$4, difficulty=$5, duration=$6,
-		 image=$7 WHERE id=$8 RETURNING id`),
-		obj.Title, obj.Description, obj.FullDescription, obj.Work, obj.Difficulty, obj.Duration, obj.Image, obj.ID)
+	query, args, err := buildUpdate("course", []column{
+		{"title", "title", obj.Title},
+		{"description", "description", obj.Description},
+		{"full_description", "full_descritpion", obj.FullDescription},
+		{"work", "work", obj.Work},
+		{"difficulty", "difficulty", obj.Difficulty},
+		{"duration_override", "duration_override", obj.DurationOverride},
+		{"image", "image", obj.Image},
+	}, fields, obj.ID, liveCourse, "id")
+	if err != nil {
+		return -1, err
+	}
 
-	err = row.Scan(&id)
+	err = r.db.QueryRow(ctx, query, args...).Scan(&id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return -1, services.ErrCourseNotFound
+		}
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.updateDurations(ctx, id); err != nil {
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return id, nil
 }
 
-func (r *CourseRepository) UpdateTheme(ctx context.Context, obj *entities.Theme) (id int, err error) {
+// UpdateTheme writes the given fields of obj, or all of them when fields is
+// empty.
+func (r *CourseRepository) UpdateTheme(ctx context.Context, obj *entities.Theme, fields ...string) (id int, err error) {
 	const op = "repositories.CourseRepository.UpdateTheme"
 
-	row := r.Pool.QueryRow(
-		ctx,
-		"UPDATE theme SET course_id=$1, title=$2 WHERE id=$3 RETURNING id",
-		obj.CourseID, obj.Title, obj.ID)
+	query, args, err := buildUpdate("theme", []column{
+		{"course_id", "course_id", obj.CourseID},
+		{"title", "title", obj.Title},
+		{"duration_override", "duration_override", obj.DurationOverride},
+		{"position", "position", obj.Position},
+	}, fields, obj.ID, inLiveCourse, "course_id")
+	if err != nil {
+		return -1, err
+	}
 
-	err = row.Scan(&id)
+	var cid int
+	err = r.db.QueryRow(ctx, query, args...).Scan(&cid)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return -1, services.ErrThemeNotFound
+		}
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return id, nil
+	if err := r.updateDurations(ctx, cid); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return obj.ID, nil
 }
 
-func (r *CourseRepository) UpdateLesson(ctx context.Context, obj *entities.Lesson) (id int, err error) {
+// UpdateLesson writes the given fields of obj, or all of them when fields is
+// empty.
+func (r *CourseRepository) UpdateLesson(ctx context.Context, obj *entities.Lesson, fields ...string) (id int, err error) {
 	const op = "repositories.CourseRepository.UpdateLesson"
 
-	row := r.Pool.QueryRow(
-		ctx,
-		(`UPDATE lesson SET course_id=$1, theme_id=$2, title=$3, type=$4, duration=$5, content=$6, task=$7 WHERE id=$8 RETURNING id`),
-		obj.CourseID, obj.ThemeID, obj.Title, obj.Type, obj.Duration, obj.Content, obj.Task, obj.ID)
+	query, args, err := buildUpdate("lesson", []column{
+		{"course_id", "course_id", obj.CourseID},
+		{"theme_id", "theme_id", obj.ThemeID},
+		{"title", "title", obj.Title},
+		{"body", "kind", string(obj.Kind)},
+		{"duration", "duration", obj.Duration},
+		{"body", "body", obj.Body()},
+		{"task", "task", obj.Task},
+		{"position", "position", obj.Position},
+	}, fields, obj.ID, inLiveCourse, "course_id")
+	if err != nil {
+		return -1, err
+	}
 
-	err = row.Scan(&id)
+	var cid int
+	err = r.db.QueryRow(ctx, query, args...).Scan(&cid)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return -1, services.ErrLessonNotFound
+		}
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return id, nil
+	if err := r.updateDurations(ctx, cid); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return obj.ID, nil
+}
+
+// updateDurations recomputes the durations of a course and its themes from
+// their lessons. Every write that can change a lesson duration, or move a
+// lesson, calls it.
+func (r *CourseRepository) updateDurations(ctx context.Context, cid int) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE theme t SET computed_duration = s.total, duration = COALESCE(t.duration_override, s.total)
+		 FROM (
+			SELECT t.id, COALESCE(sum(l.duration), 0) AS total
+			FROM theme t LEFT JOIN lesson l ON l.theme_id = t.id
+			WHERE t.course_id = $1 GROUP BY t.id
+		 ) s
+		 WHERE t.id = s.id`,
+		cid)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx,
+		`UPDATE course SET computed_duration = s.total, duration = COALESCE(duration_override, s.total)
+		 FROM (SELECT COALESCE(sum(duration), 0) AS total FROM lesson WHERE course_id = $1) s
+		 WHERE id = $1`,
+		cid)
+	return err
+}
+
+// ReorderThemes sets the position of every theme in ids to its index.
+func (r *CourseRepository) ReorderThemes(ctx context.Context, cid int, ids []int) error {
+	const op = "repositories.CourseRepository.ReorderThemes"
+
+	tag, err := r.db.Exec(ctx,
+		`UPDATE theme t SET position = u.pos - 1
+		 FROM unnest($2::int[]) WITH ORDINALITY AS u(id, pos)
+		 WHERE t.id = u.id AND t.course_id = $1`,
+		cid, ids)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() != int64(len(ids)) {
+		return services.ErrThemeNotFound
+	}
+
+	return nil
+}
+
+// ReorderLessons moves every lesson in ids into theme tid, positioned by its
+// index. Lessons may come from other themes of the same course.
+func (r *CourseRepository) ReorderLessons(ctx context.Context, cid, tid int, ids []int) error {
+	const op = "repositories.CourseRepository.ReorderLessons"
+
+	tag, err := r.db.Exec(ctx,
+		`UPDATE lesson l SET theme_id = $2, position = u.pos - 1
+		 FROM unnest($3::int[]) WITH ORDINALITY AS u(id, pos)
+		 WHERE l.id = u.id AND l.course_id = $1`,
+		cid, tid, ids)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() != int64(len(ids)) {
+		return services.ErrLessonNotFound
+	}
+
+	if err := r.updateDurations(ctx, cid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetCourseStatus moves a course from t.From to t.To and records the
+// transition. It fails with ErrCourseStatusChanged if the course is no
+// longer in t.From.
+func (r *CourseRepository) SetCourseStatus(ctx context.Context, t *entities.StatusTransition) error {
+	const op = "repositories.CourseRepository.SetCourseStatus"
+
+	tag, err := r.db.Exec(ctx,
+		"UPDATE course SET status=$1 WHERE id=$2 AND status=$3",
+		string(t.To), t.CourseID, string(t.From))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return services.ErrCourseStatusChanged
+	}
+
+	var actor *int
+	if t.ActorID != 0 {
+		actor = &t.ActorID
+	}
+
+	err = r.db.QueryRow(ctx,
+		`INSERT INTO course_status_transition(course_id, from_status, to_status, actor_id)
+		 VALUES ($1, $2, $3, $4) RETURNING created_at`,
+		t.CourseID, string(t.From), string(t.To), actor).Scan(&t.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) RestoreCourse(ctx context.Context, id int) error {
+	const op = "repositories.CourseRepository.RestoreCourse"
+
+	tag, err := r.db.Exec(ctx,
+		"UPDATE course SET deleted_at=NULL WHERE id=$1 AND deleted_at IS NOT NULL", id)
+	if err != nil {
+		// The archive of the course was imported again after it was deleted.
+		if postgres.ErrCode(err) == postgres.UniqueViolation {
+			return services.ErrCourseAlreadyExists
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return services.ErrCourseNotFound
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) GetDeletedCourses(ctx context.Context) ([]*entities.Course, error) {
+	const op = "repositories.CourseRepository.GetDeletedCourses"
+	arraySize := 20
+	rows, err := r.db.Query(ctx,
+		"SELECT "+courseColumns+" FROM course WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	courses := make([]*entities.Course, 0, arraySize)
+	for rows.Next() {
+		var obj entities.Course
+		if err := scanCourse(rows, &obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		courses = append(courses, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return courses, nil
+}
+
+// PurgeCourses hard-deletes courses that were soft-deleted before the given
+// time, together with their themes and lessons.
+func (r *CourseRepository) PurgeCourses(ctx context.Context, before time.Time) (int64, error) {
+	const op = "repositories.CourseRepository.PurgeCourses"
+
+	tag, err := r.db.Exec(ctx,
+		"DELETE FROM course WHERE deleted_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
 }
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+)
+
+const (
+	benchThemes  = 30
+	benchLessons = 10
+)
+
+// newBenchRepository connects to the migrated database in PG_URL and skips
+// the benchmark when it is not set.
+func newBenchRepository(b *testing.B) *CourseRepository {
+	url := os.Getenv("PG_URL")
+	if url == "" {
+		b.Skip("PG_URL is not set")
+	}
+
+	pg, err := postgres.New(url, postgres.MaxPoolSize(2), postgres.ConnAttempts(1))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(pg.Close)
+
+	return NewCourseRepository(pg)
+}
+
+// seedCourse creates a course with benchThemes themes of benchLessons
+// lessons each and removes it when the benchmark is done.
+func seedCourse(b *testing.B, r *CourseRepository) int {
+	ctx := context.Background()
+
+	cid, err := r.Create(ctx, &entities.Course{
+		Title:      fmt.Sprintf("benchmark %d", os.Getpid()),
+		Difficulty: entities.DifficultyEasy,
+		Status:     entities.StatusPublished,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() {
+		_, _ = r.db.Exec(ctx, "DELETE FROM course WHERE id=$1", cid)
+	})
+
+	for i := 0; i < benchThemes; i++ {
+		tid, err := r.CreateTheme(ctx, &entities.Theme{CourseID: cid, Title: fmt.Sprintf("theme %d", i), Position: i})
+		if err != nil {
+			b.Fatal(err)
+		}
+		for j := 0; j < benchLessons; j++ {
+			lesson := &entities.Lesson{CourseID: cid, ThemeID: tid, Title: fmt.Sprintf("lesson %d", j), Duration: 10, Position: j}
+			lesson.SetBody(&entities.TextBody{Markdown: "# Lesson\n\nSome text."})
+			if _, err := r.CreateLesson(ctx, lesson); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	return cid
+}
+
+// BenchmarkGetCourse compares loading a course tree theme by theme, as Get
+// used to, with GetCourseTree.
+func BenchmarkGetCourse(b *testing.B) {
+	r := newBenchRepository(b)
+	cid := seedCourse(b, r)
+	ctx := context.Background()
+
+	b.Run("PerTheme", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			course, err := r.GetCourse(ctx, cid)
+			if err != nil {
+				b.Fatal(err)
+			}
+			course.Themes, err = r.GetThemes(ctx, cid)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, theme := range course.Themes {
+				theme.Lessons, err = r.GetLessons(ctx, cid, theme.ID)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("Tree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := r.GetCourseTree(ctx, cid); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type EnrollmentRepository struct {
+	*postgres.Postgres
+	db postgres.DBTX
+}
+
+func NewEnrollmentRepository(pg *postgres.Postgres) *EnrollmentRepository {
+	return &EnrollmentRepository{Postgres: pg, db: pg.Pool}
+}
+
+// WithTx runs fn against a copy of the repository bound to a single
+// transaction. Everything fn does is committed together or not at all.
+func (r *EnrollmentRepository) WithTx(ctx context.Context, fn func(repo services.EnrollmentRepo) error) error {
+	const op = "repositories.EnrollmentRepository.WithTx"
+
+	err := postgres.InTx(ctx, r.db, func(tx pgx.Tx) error {
+		return fn(&EnrollmentRepository{Postgres: r.Postgres, db: tx})
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+const enrollmentColumns = "course_id, user_id, status, enrolled_at, updated_at"
+
+func scanEnrollment(row pgx.Row, obj *entities.Enrollment) error {
+	return row.Scan(&obj.CourseID, &obj.UserID, &obj.Status, &obj.EnrolledAt, &obj.UpdatedAt)
+}
+
+// Enroll creates an active enrollment, or reactivates a dropped one.
+func (r *EnrollmentRepository) Enroll(ctx context.Context, obj *entities.Enrollment) error {
+	const op = "repositories.EnrollmentRepository.Enroll"
+
+	row := r.db.QueryRow(
+		ctx,
+		`INSERT INTO enrollment(course_id, user_id) VALUES ($1, $2)
+		 ON CONFLICT (course_id, user_id) DO UPDATE SET status = 'active', enrolled_at = now(), updated_at = now()
+		 WHERE enrollment.status = 'dropped'
+		 RETURNING `+enrollmentColumns,
+		obj.CourseID, obj.UserID)
+
+	err := scanEnrollment(row, obj)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return services.ErrAlreadyEnrolled
+		}
+		if postgres.ErrCode(err) == postgres.ForeignKeyViolation {
+			return services.ErrCourseNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *EnrollmentRepository) GetEnrollment(ctx context.Context, cid, uid int) (*entities.Enrollment, error) {
+	const op = "repositories.EnrollmentRepository.GetEnrollment"
+
+	row := r.db.QueryRow(ctx,
+		"SELECT "+enrollmentColumns+" FROM enrollment WHERE course_id=$1 AND user_id=$2 AND "+inLiveCourse,
+		cid, uid)
+
+	var obj entities.Enrollment
+	if err := scanEnrollment(row, &obj); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrEnrollmentNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &obj, nil
+}
+
+func (r *EnrollmentRepository) SetEnrollmentStatus(ctx context.Context, obj *entities.Enrollment) error {
+	const op = "repositories.EnrollmentRepository.SetEnrollmentStatus"
+
+	row := r.db.QueryRow(ctx,
+		`UPDATE enrollment SET status=$3, updated_at=now() WHERE course_id=$1 AND user_id=$2
+		 RETURNING `+enrollmentColumns,
+		obj.CourseID, obj.UserID, string(obj.Status))
+
+	if err := scanEnrollment(row, obj); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return services.ErrEnrollmentNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetUserEnrollments returns the enrollments of a user in live courses,
+// newest first. An empty statuses list means any status.
+func (r *EnrollmentRepository) GetUserEnrollments(ctx context.Context, uid int, statuses ...entities.EnrollmentStatus) ([]*entities.Enrollment, error) {
+	const op = "repositories.EnrollmentRepository.GetUserEnrollments"
+
+	enrollments, err := r.getEnrollments(ctx, "user_id=$1", uid, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return enrollments, nil
+}
+
+// GetCourseEnrollments returns the enrollments of a course, newest first.
+// An empty statuses list means any status.
+func (r *EnrollmentRepository) GetCourseEnrollments(ctx context.Context, cid int, statuses ...entities.EnrollmentStatus) ([]*entities.Enrollment, error) {
+	const op = "repositories.EnrollmentRepository.GetCourseEnrollments"
+
+	enrollments, err := r.getEnrollments(ctx, "course_id=$1", cid, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return enrollments, nil
+}
+
+func (r *EnrollmentRepository) getEnrollments(ctx context.Context, cond string, id int, statuses []entities.EnrollmentStatus) ([]*entities.Enrollment, error) {
+	arraySize := 20
+	rows, err := r.db.Query(ctx,
+		"SELECT "+enrollmentColumns+" FROM enrollment WHERE "+cond+" AND "+inLiveCourse+
+			" AND ($2::text[] IS NULL OR status = ANY($2::text[])) ORDER BY enrolled_at DESC, course_id, user_id",
+		id, statusStrings(statuses))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	enrollments := make([]*entities.Enrollment, 0, arraySize)
+	for rows.Next() {
+		var obj entities.Enrollment
+		if err := scanEnrollment(rows, &obj); err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, &obj)
+	}
+
+	return enrollments, rows.Err()
+}
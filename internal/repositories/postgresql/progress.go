@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const progressColumns = "user_id, lesson_id, course_id, started_at, completed_at"
+
+func scanProgress(row pgx.Row, obj *entities.LessonProgress) error {
+	return row.Scan(&obj.UserID, &obj.LessonID, &obj.CourseID, &obj.StartedAt, &obj.CompletedAt)
+}
+
+// StartLesson records that the user opened the lesson. Starting it again
+// keeps the first start time.
+func (r *EnrollmentRepository) StartLesson(ctx context.Context, obj *entities.LessonProgress) error {
+	const op = "repositories.EnrollmentRepository.StartLesson"
+
+	row := r.db.QueryRow(
+		ctx,
+		`INSERT INTO lesson_progress(user_id, lesson_id, course_id) VALUES ($1, $2, $3)
+		 ON CONFLICT (user_id, lesson_id) DO UPDATE SET started_at = lesson_progress.started_at
+		 RETURNING `+progressColumns,
+		obj.UserID, obj.LessonID, obj.CourseID)
+
+	if err := scanProgress(row, obj); err != nil {
+		if postgres.ErrCode(err) == postgres.ForeignKeyViolation {
+			return services.ErrLessonNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CompleteLesson records that the user finished the lesson. Completing it
+// again keeps the first completion time.
+func (r *EnrollmentRepository) CompleteLesson(ctx context.Context, obj *entities.LessonProgress) error {
+	const op = "repositories.EnrollmentRepository.CompleteLesson"
+
+	row := r.db.QueryRow(
+		ctx,
+		`INSERT INTO lesson_progress(user_id, lesson_id, course_id, completed_at) VALUES ($1, $2, $3, now())
+		 ON CONFLICT (user_id, lesson_id) DO UPDATE SET completed_at = COALESCE(lesson_progress.completed_at, now())
+		 RETURNING `+progressColumns,
+		obj.UserID, obj.LessonID, obj.CourseID)
+
+	if err := scanProgress(row, obj); err != nil {
+		if postgres.ErrCode(err) == postgres.ForeignKeyViolation {
+			return services.ErrLessonNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetThemeProgress counts, for every theme of the course in syllabus order,
+// the lessons and lesson durations the user has completed.
+func (r *EnrollmentRepository) GetThemeProgress(ctx context.Context, cid, uid int) ([]*entities.ThemeProgress, error) {
+	const op = "repositories.EnrollmentRepository.GetThemeProgress"
+	arraySize := 10
+	rows, err := r.db.Query(ctx, `
+SELECT t.id, count(p.completed_at), count(l.id),
+	COALESCE(sum(l.duration) FILTER (WHERE p.completed_at IS NOT NULL), 0), COALESCE(sum(l.duration), 0)
+FROM theme t
+LEFT JOIN lesson l ON l.theme_id = t.id
+LEFT JOIN lesson_progress p ON p.lesson_id = l.id AND p.user_id = $2
+WHERE t.course_id = $1
+GROUP BY t.id, t.position
+ORDER BY t.position, t.id`, cid, uid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	themes := make([]*entities.ThemeProgress, 0, arraySize)
+	for rows.Next() {
+		var obj entities.ThemeProgress
+		err := rows.Scan(&obj.ThemeID, &obj.CompletedLessons, &obj.TotalLessons,
+			&obj.CompletedDuration, &obj.TotalDuration)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		themes = append(themes, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return themes, nil
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const revisionColumns = "id, entity_type, entity_id, course_id, version, snapshot, author_id, created_at"
+
+func scanRevision(row pgx.Row, obj *entities.Revision) error {
+	var author *int
+	err := row.Scan(&obj.ID, &obj.Entity, &obj.EntityID, &obj.CourseID, &obj.Version,
+		&obj.Fields, &author, &obj.CreatedAt)
+	if author != nil {
+		obj.AuthorID = *author
+	}
+	return err
+}
+
+// CreateRevision stores obj as the next version of its entity and fills in
+// the generated id, version and timestamp.
+func (r *CourseRepository) CreateRevision(ctx context.Context, obj *entities.Revision) error {
+	const op = "repositories.CourseRepository.CreateRevision"
+
+	var author *int
+	if obj.AuthorID != 0 {
+		author = &obj.AuthorID
+	}
+
+	row := r.db.QueryRow(
+		ctx,
+		`INSERT INTO revision(entity_type, entity_id, course_id, version, snapshot, author_id)
+		 VALUES ($1, $2, $3,
+		 	COALESCE((SELECT max(version) FROM revision WHERE entity_type=$1 AND entity_id=$2), 0) + 1,
+		 	$4, $5)
+		 RETURNING id, version, created_at`,
+		string(obj.Entity), obj.EntityID, obj.CourseID, obj.Fields, author)
+
+	err := row.Scan(&obj.ID, &obj.Version, &obj.CreatedAt)
+	if err != nil {
+		if postgres.ErrCode(err) == postgres.UniqueViolation {
+			return services.ErrRevisionConflict
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) GetRevisions(ctx context.Context, entity entities.RevisionEntity, id int) ([]*entities.Revision, error) {
+	const op = "repositories.CourseRepository.GetRevisions"
+	arraySize := 20
+	rows, err := r.db.Query(ctx,
+		"SELECT "+revisionColumns+" FROM revision WHERE entity_type=$1 AND entity_id=$2 ORDER BY version DESC",
+		string(entity), id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	revisions := make([]*entities.Revision, 0, arraySize)
+	for rows.Next() {
+		var obj entities.Revision
+		if err := scanRevision(rows, &obj); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		revisions = append(revisions, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revisions, nil
+}
+
+func (r *CourseRepository) GetRevision(ctx context.Context, entity entities.RevisionEntity, id, version int) (*entities.Revision, error) {
+	const op = "repositories.CourseRepository.GetRevision"
+
+	row := r.db.QueryRow(ctx,
+		"SELECT "+revisionColumns+" FROM revision WHERE entity_type=$1 AND entity_id=$2 AND version=$3",
+		string(entity), id, version)
+
+	var obj entities.Revision
+	if err := scanRevision(row, &obj); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &obj, nil
+}
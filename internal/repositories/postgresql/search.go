@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+const (
+	searchConfig = "russian"
+
+	// Matches in themes and lessons count for less than a match in the
+	// course itself.
+	themeRankWeight  = 0.6
+	lessonRankWeight = 0.4
+
+	matchesPerCourse = 3
+
+	// ts_headline marks matches with private use characters instead of
+	// <mark> tags, so the text around them can be escaped before the tags
+	// are put in; see highlight.
+	markStart       = "\uE000"
+	markStop        = "\uE001"
+	headlineOptions = "StartSel=" + markStart + ", StopSel=" + markStop + ", MaxFragments=2, MaxWords=20, MinWords=5"
+
+	// lessonSearchText is the searchable text of a lesson body. It has to
+	// match the expression of the lesson search_vector column.
+	lessonSearchText = "coalesce(l.body->>'markdown', l.body->>'instructions', l.body->>'label', '')"
+)
+
+// searchHitsCTE ranks every course that matches the query directly or
+// through one of its themes or lessons. $1 is the raw user query.
+var searchHitsCTE = fmt.Sprintf(`WITH q AS (SELECT websearch_to_tsquery('%[1]s', $1) AS query),
+hits AS (
+	SELECT c.id AS course_id, ts_rank(c.search_vector, q.query) AS rank
+	FROM course c, q WHERE c.search_vector @@ q.query
+	UNION ALL
+	SELECT t.course_id, ts_rank(t.search_vector, q.query) * %[2]g
+	FROM theme t, q WHERE t.search_vector @@ q.query
+	UNION ALL
+	SELECT l.course_id, ts_rank(l.search_vector, q.query) * %[3]g
+	FROM lesson l, q WHERE l.search_vector @@ q.query
+),
+ranked AS (SELECT course_id, sum(rank) AS rank FROM hits GROUP BY course_id)`,
+	searchConfig, themeRankWeight, lessonRankWeight)
+
+func (r *CourseRepository) SearchCourses(ctx context.Context, params entities.SearchParams) (*entities.SearchResult, error) {
+	const op = "repositories.CourseRepository.SearchCourses"
+
+	res := &entities.SearchResult{}
+	statuses := statusStrings(params.Statuses)
+	err := r.db.QueryRow(ctx, searchHitsCTE+`
+SELECT count(*) FROM ranked r JOIN course c ON c.id = r.course_id
+WHERE c.deleted_at IS NULL AND ($2::text[] IS NULL OR c.status = ANY($2::text[]))`,
+		params.Query, statuses).Scan(&res.Total)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if res.Total == 0 {
+		return res, nil
+	}
+
+	query := fmt.Sprintf(`%s
+SELECT c.id, c.title, c.description, c.full_descritpion, c.work, c.difficulty, c.duration, c.image, c.status, c.created_at, c.deleted_at, COALESCE(c.owner_id, 0),
+	r.rank::real, ts_headline('%s', c.description, q.query, '%s')
+FROM ranked r JOIN course c ON c.id = r.course_id, q
+WHERE c.deleted_at IS NULL AND ($4::text[] IS NULL OR c.status = ANY($4::text[]))
+ORDER BY r.rank DESC, c.id
+LIMIT $2 OFFSET $3`, searchHitsCTE, searchConfig, headlineOptions)
+
+	rows, err := r.db.Query(ctx, query, params.Query, params.Limit, params.Offset, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	byCourse := make(map[int]*entities.SearchHit)
+	ids := make([]int, 0, params.Limit)
+	for rows.Next() {
+		hit := &entities.SearchHit{Course: &entities.Course{}}
+		c := hit.Course
+		err := rows.Scan(&c.ID, &c.Title, &c.Description, &c.FullDescription, &c.Work,
+			&c.Difficulty, &c.Duration, &c.Image, &c.Status, &c.CreatedAt, &c.DeletedAt, &c.OwnerID, &hit.Rank, &hit.Snippet)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		hit.Snippet = highlight(hit.Snippet)
+		res.Hits = append(res.Hits, hit)
+		byCourse[c.ID] = hit
+		ids = append(ids, c.ID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.searchMatches(ctx, params.Query, ids, byCourse); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// searchMatches attaches the best matching themes and lessons to each hit.
+func (r *CourseRepository) searchMatches(ctx context.Context, q string, ids []int, hits map[int]*entities.SearchHit) error {
+	query := fmt.Sprintf(`WITH q AS (SELECT websearch_to_tsquery('%[1]s', $1) AS query),
+matches AS (
+	SELECT 'theme' AS kind, t.id, t.id AS theme_id, t.course_id, t.title,
+		ts_headline('%[1]s', t.title, q.query, '%[2]s') AS snippet,
+		ts_rank(t.search_vector, q.query) AS rank
+	FROM theme t, q WHERE t.course_id = ANY($2) AND t.search_vector @@ q.query
+	UNION ALL
+	SELECT 'lesson', l.id, l.theme_id, l.course_id, l.title,
+		ts_headline('%[1]s', %[3]s, q.query, '%[2]s'),
+		ts_rank(l.search_vector, q.query)
+	FROM lesson l, q WHERE l.course_id = ANY($2) AND l.search_vector @@ q.query
+)
+SELECT kind, id, theme_id, course_id, title, snippet FROM (
+	SELECT *, row_number() OVER (PARTITION BY course_id ORDER BY rank DESC, id) AS n FROM matches
+) m WHERE n <= $3
+ORDER BY course_id, n`, searchConfig, headlineOptions, lessonSearchText)
+
+	rows, err := r.db.Query(ctx, query, q, ids, matchesPerCourse)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			m        entities.SearchMatch
+			courseID int
+		)
+		if err := rows.Scan(&m.Kind, &m.ID, &m.ThemeID, &courseID, &m.Title, &m.Snippet); err != nil {
+			return err
+		}
+		m.Snippet = highlight(m.Snippet)
+		if hit, ok := hits[courseID]; ok {
+			hit.Matches = append(hit.Matches, m)
+		}
+	}
+
+	return rows.Err()
+}
+
+var markReplacer = strings.NewReplacer(markStart, "<mark>", markStop, "</mark>")
+
+// highlight turns a ts_headline snippet into HTML: the text of the course,
+// theme or lesson is escaped, only the match markers become tags.
+func highlight(snippet string) string {
+	return markReplacer.Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+// streamPageSize is how many rows a stream reads at a time. Every page is
+// read with its own query, so no connection is held while the client
+// receives what was read, however slow it is.
+const streamPageSize = 100
+
+// StreamCourses calls fn for every course of the catalog in id order. The
+// courses are read page by page instead of being collected first.
+func (r *CourseRepository) StreamCourses(ctx context.Context, fn func(*entities.Course) error, statuses ...entities.CourseStatus) error {
+	const op = "repositories.CourseRepository.StreamCourses"
+
+	after := 0
+	for {
+		courses, err := r.coursePage(ctx, statuses, after)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, obj := range courses {
+			if err := fn(obj); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if len(courses) < streamPageSize {
+			return nil
+		}
+		after = courses[len(courses)-1].ID
+	}
+}
+
+// StreamCourseContent calls fn for the course, its themes and its lessons
+// in the order described at entities.CourseContent. Themes are few and
+// read at once, the lessons of each theme page by page. Pages are read
+// separately, so changes made while the course is streamed may show up in
+// the pages read after them.
+func (r *CourseRepository) StreamCourseContent(ctx context.Context, cid int, fn func(*entities.CourseContent) error) error {
+	const op = "repositories.CourseRepository.StreamCourseContent"
+
+	course, err := r.GetCourse(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := fn(&entities.CourseContent{Course: course}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	themes, err := r.GetThemes(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, th := range themes {
+		if err := fn(&entities.CourseContent{Theme: th}); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		afterPosition, afterID := -1, 0
+		for {
+			lessons, err := r.lessonPage(ctx, cid, th.ID, afterPosition, afterID)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+
+			for _, obj := range lessons {
+				if err := fn(&entities.CourseContent{Lesson: obj}); err != nil {
+					return fmt.Errorf("%s: %w", op, err)
+				}
+			}
+
+			if len(lessons) < streamPageSize {
+				break
+			}
+			last := lessons[len(lessons)-1]
+			afterPosition, afterID = last.Position, last.ID
+		}
+	}
+
+	return nil
+}
+
+// coursePage returns the next page of live courses with an id above after.
+func (r *CourseRepository) coursePage(ctx context.Context, statuses []entities.CourseStatus, after int) ([]*entities.Course, error) {
+	rows, err := r.db.Query(ctx,
+		"SELECT "+courseColumns+" FROM course WHERE "+liveCourse+
+			" AND ($1::text[] IS NULL OR status = ANY($1::text[])) AND id > $2 ORDER BY id LIMIT $3",
+		statusStrings(statuses), after, streamPageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := make([]*entities.Course, 0, streamPageSize)
+	for rows.Next() {
+		var obj entities.Course
+		if err := scanCourse(rows, &obj); err != nil {
+			return nil, err
+		}
+		courses = append(courses, &obj)
+	}
+
+	return courses, rows.Err()
+}
+
+// lessonPage returns the next page of the lessons of a theme, the ones
+// after (afterPosition, afterID) in position order.
+func (r *CourseRepository) lessonPage(ctx context.Context, cid, tid, afterPosition, afterID int) ([]*entities.Lesson, error) {
+	rows, err := r.db.Query(ctx,
+		"SELECT "+lessonColumns+" FROM lesson WHERE course_id=$1 AND theme_id=$2 AND (position, id) > ($3, $4) ORDER BY position, id LIMIT $5",
+		cid, tid, afterPosition, afterID, streamPageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lessons := make([]*entities.Lesson, 0, streamPageSize)
+	for rows.Next() {
+		var obj entities.Lesson
+		if err := scanLesson(rows, &obj); err != nil {
+			return nil, err
+		}
+		lessons = append(lessons, &obj)
+	}
+
+	return lessons, rows.Err()
+}
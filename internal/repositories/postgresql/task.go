@@ -0,0 +1,205 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// taskSpec returns the kind specific body of a task, which is stored as
+// JSON.
+func taskSpec(obj *entities.Task) any {
+	switch obj.Kind {
+	case entities.TaskSingleChoice, entities.TaskMultipleChoice:
+		return obj.Choice
+	case entities.TaskFreeText:
+		return obj.FreeText
+	case entities.TaskCode:
+		return obj.Code
+	}
+	return nil
+}
+
+func setTaskSpec(obj *entities.Task, spec []byte) error {
+	switch obj.Kind {
+	case entities.TaskSingleChoice, entities.TaskMultipleChoice:
+		obj.Choice = &entities.ChoiceTask{}
+		return json.Unmarshal(spec, obj.Choice)
+	case entities.TaskFreeText:
+		obj.FreeText = &entities.FreeTextTask{}
+		return json.Unmarshal(spec, obj.FreeText)
+	case entities.TaskCode:
+		obj.Code = &entities.CodeTask{}
+		return json.Unmarshal(spec, obj.Code)
+	}
+	return fmt.Errorf("unknown task kind %q", obj.Kind)
+}
+
+// SetTask creates the task of a lesson or replaces the existing one.
+func (r *CourseRepository) SetTask(ctx context.Context, obj *entities.Task) error {
+	const op = "repositories.CourseRepository.SetTask"
+
+	_, err := r.db.Exec(
+		ctx,
+		`INSERT INTO lesson_task(lesson_id, course_id, kind, max_score, spec) VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (lesson_id) DO UPDATE SET kind = EXCLUDED.kind, max_score = EXCLUDED.max_score, spec = EXCLUDED.spec`,
+		obj.LessonID, obj.CourseID, string(obj.Kind), obj.MaxScore, taskSpec(obj))
+	if err != nil {
+		if postgres.ErrCode(err) == postgres.ForeignKeyViolation {
+			return services.ErrLessonNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) GetTask(ctx context.Context, lid int) (*entities.Task, error) {
+	const op = "repositories.CourseRepository.GetTask"
+
+	row := r.db.QueryRow(ctx,
+		"SELECT lesson_id, course_id, kind, max_score, spec FROM lesson_task WHERE lesson_id=$1 AND "+inLiveCourse,
+		lid)
+
+	var obj entities.Task
+	var spec []byte
+	if err := row.Scan(&obj.LessonID, &obj.CourseID, &obj.Kind, &obj.MaxScore, &spec); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := setTaskSpec(&obj, spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &obj, nil
+}
+
+// GetCourseTasks returns the tasks of every lesson of a course.
+func (r *CourseRepository) GetCourseTasks(ctx context.Context, cid int) ([]*entities.Task, error) {
+	const op = "repositories.CourseRepository.GetCourseTasks"
+
+	rows, err := r.db.Query(ctx,
+		"SELECT lesson_id, course_id, kind, max_score, spec FROM lesson_task WHERE course_id=$1 AND "+inLiveCourse+" ORDER BY lesson_id",
+		cid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	tasks := make([]*entities.Task, 0)
+	for rows.Next() {
+		var obj entities.Task
+		var spec []byte
+		if err := rows.Scan(&obj.LessonID, &obj.CourseID, &obj.Kind, &obj.MaxScore, &spec); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := setTaskSpec(&obj, spec); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, &obj)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+const attemptColumns = "id, lesson_id, course_id, user_id, answer, score, max_score, status, created_at"
+
+func (r *EnrollmentRepository) CreateAttempt(ctx context.Context, obj *entities.Attempt) error {
+	const op = "repositories.EnrollmentRepository.CreateAttempt"
+
+	row := r.db.QueryRow(
+		ctx,
+		`INSERT INTO task_attempt(lesson_id, course_id, user_id, answer, score, max_score, status)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at`,
+		obj.LessonID, obj.CourseID, obj.UserID, obj.Answer, obj.Score, obj.MaxScore, string(obj.Status))
+
+	if err := row.Scan(&obj.ID, &obj.CreatedAt); err != nil {
+		if postgres.ErrCode(err) == postgres.ForeignKeyViolation {
+			return services.ErrLessonNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetAttempts returns the attempts at a lesson's task, newest first. uid 0
+// returns the attempts of every user.
+func (r *EnrollmentRepository) GetAttempts(ctx context.Context, lid, uid int) ([]*entities.Attempt, error) {
+	const op = "repositories.EnrollmentRepository.GetAttempts"
+	arraySize := 10
+	rows, err := r.db.Query(ctx,
+		"SELECT "+attemptColumns+" FROM task_attempt WHERE lesson_id=$1 AND ($2 = 0 OR user_id = $2) ORDER BY created_at DESC, id DESC",
+		lid, uid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*entities.Attempt, 0, arraySize)
+	for rows.Next() {
+		var obj entities.Attempt
+		err := rows.Scan(&obj.ID, &obj.LessonID, &obj.CourseID, &obj.UserID, &obj.Answer,
+			&obj.Score, &obj.MaxScore, &obj.Status, &obj.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		attempts = append(attempts, &obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attempts, nil
+}
+
+func (r *EnrollmentRepository) GetAttempt(ctx context.Context, id int) (*entities.Attempt, error) {
+	const op = "repositories.EnrollmentRepository.GetAttempt"
+
+	var obj entities.Attempt
+	err := r.db.QueryRow(ctx, "SELECT "+attemptColumns+" FROM task_attempt WHERE id=$1", id).
+		Scan(&obj.ID, &obj.LessonID, &obj.CourseID, &obj.UserID, &obj.Answer,
+			&obj.Score, &obj.MaxScore, &obj.Status, &obj.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, services.ErrAttemptNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &obj, nil
+}
+
+// GradeAttempt writes the score of an attempt that is pending review and
+// marks it graded. It fails with ErrAttemptGraded if the attempt was
+// graded in the meantime.
+func (r *EnrollmentRepository) GradeAttempt(ctx context.Context, obj *entities.Attempt) error {
+	const op = "repositories.EnrollmentRepository.GradeAttempt"
+
+	tag, err := r.db.Exec(ctx,
+		"UPDATE task_attempt SET score=$2, status=$3 WHERE id=$1 AND status=$4",
+		obj.ID, obj.Score, string(entities.AttemptGraded), string(entities.AttemptPendingReview))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return services.ErrAttemptGraded
+	}
+	obj.Status = entities.AttemptGraded
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/services"
+)
+
+// column binds an entity field, named as in the proto definition, to its
+// database column and the value to write. A field may span several
+// columns.
+type column struct {
+	field string
+	name  string
+	value any
+}
+
+// buildUpdate returns an UPDATE statement for the columns whose field is
+// listed in fields, or for every column when fields is empty. cond is an
+// extra condition on the updated row and returning the column the statement
+// returns.
+func buildUpdate(table string, columns []column, fields []string, id int, cond, returning string) (string, []any, error) {
+	byField := make(map[string][]column, len(columns))
+	for _, c := range columns {
+		byField[c.field] = append(byField[c.field], c)
+	}
+
+	selected := columns
+	if len(fields) > 0 {
+		selected = make([]column, 0, len(fields))
+		for _, f := range fields {
+			cs, ok := byField[f]
+			if !ok {
+				return "", nil, services.ErrInvalidFieldMask.WithMetadata("path", f)
+			}
+			selected = append(selected, cs...)
+		}
+	}
+
+	sets := make([]string, len(selected))
+	args := make([]any, 0, len(selected)+1)
+	for i, c := range selected {
+		args = append(args, c.value)
+		sets[i] = fmt.Sprintf("%s=$%d", c.name, len(args))
+	}
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE id=$%d AND %s RETURNING %s",
+		table, strings.Join(sets, ", "), len(args), cond, returning)
+
+	return query, args, nil
+}
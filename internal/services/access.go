@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var ErrPermissionDenied = entities.NewError(entities.KindPermissionDenied, "PERMISSION_DENIED", "not allowed to perform this action")
+
+// authorRole returns the role of the caller in the course. Admins act as
+// owners of every course; callers without the author role have no role.
+func authorRole(ctx context.Context, repo CourseRepo, cid int) (entities.AuthorRole, error) {
+	p := auth.FromContext(ctx)
+
+	role, err := repo.GetAuthorRole(ctx, cid, auth.UserID(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case p.HasRole(auth.RoleAdmin):
+		return entities.AuthorOwner, nil
+	case p.HasRole(auth.RoleAuthor):
+		return role, nil
+	}
+	return "", nil
+}
+
+// checkOwner fails with ErrPermissionDenied unless the caller owns the
+// course.
+func checkOwner(ctx context.Context, repo CourseRepo, cid int) error {
+	role, err := authorRole(ctx, repo, cid)
+	if err != nil {
+		return err
+	}
+
+	if role != entities.AuthorOwner {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// checkAuthor fails with ErrPermissionDenied unless the caller has any
+// role in the course, viewers included.
+func checkAuthor(ctx context.Context, repo CourseRepo, cid int) error {
+	role, err := authorRole(ctx, repo, cid)
+	if err != nil {
+		return err
+	}
+
+	if role == "" {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// checkEditor fails with ErrPermissionDenied unless the caller may change
+// the content of the course.
+func checkEditor(ctx context.Context, repo CourseRepo, cid int) error {
+	role, err := authorRole(ctx, repo, cid)
+	if err != nil {
+		return err
+	}
+
+	if !role.CanEdit() {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// checkThemeEditor is checkEditor for the course the theme belongs to. It
+// returns the id of that course.
+func checkThemeEditor(ctx context.Context, repo CourseRepo, tid int) (int, error) {
+	theme, err := repo.GetTheme(ctx, tid)
+	if err != nil {
+		return 0, err
+	}
+	return theme.CourseID, checkEditor(ctx, repo, theme.CourseID)
+}
+
+// checkLessonEditor is checkEditor for the course the lesson belongs to. It
+// returns the id of that course.
+func checkLessonEditor(ctx context.Context, repo CourseRepo, lid int) (int, error) {
+	lesson, err := repo.GetLesson(ctx, lid)
+	if err != nil {
+		return 0, err
+	}
+	return lesson.CourseID, checkEditor(ctx, repo, lesson.CourseID)
+}
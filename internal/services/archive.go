@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
+)
+
+// ExportCourse returns the course tree to be written into an archive,
+// with the typed task of every lesson that has one. The archive holds the
+// course whatever its status, so only its editors may export it.
+func (s *CourseService) ExportCourse(ctx context.Context, id int) (*entities.Course, error) {
+	const op = "Course.ExportCourse"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("id", id),
+	)
+
+	log.Info("trying to export course")
+	if err := checkEditor(ctx, s.crsRepo, id); err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	course, err := s.crsRepo.GetCourseTree(ctx, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, err := s.crsRepo.GetCourseTasks(ctx, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	byLesson := make(map[int]*entities.Task, len(tasks))
+	for _, task := range tasks {
+		byLesson[task.LessonID] = task
+	}
+	for _, th := range course.Themes {
+		for _, ls := range th.Lessons {
+			ls.TaskSpec = byLesson[ls.ID]
+		}
+	}
+	log.Info("course successfully exported")
+
+	return course, nil
+}
+
+// ImportCourse writes a course read from an archive. A course with the
+// same external key is updated to match the archive: themes and lessons
+// are matched by their keys, the ones missing from the archive are
+// deleted. Otherwise a new draft course owned by the caller is created.
+// created reports which of the two happened. Typed tasks in the archive
+// replace the ones of their lessons; lessons without one keep theirs.
+func (s *CourseService) ImportCourse(ctx context.Context, obj *entities.Course) (id int, created bool, err error) {
+	const op = "Course.ImportCourse"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("key", obj.ExternalKey),
+	)
+
+	if err := validation.Course(obj); err != nil {
+		return -1, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderThemes(obj.Themes); err != nil {
+		return -1, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("trying to import course")
+	err = s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		cid, err := repo.GetCourseIDByKey(ctx, obj.ExternalKey)
+		if errors.Is(err, ErrCourseNotFound) {
+			created = true
+			obj.Status = entities.StatusDraft
+			obj.OwnerID = auth.UserID(ctx)
+			if err := createCourse(ctx, repo, obj); err != nil {
+				return err
+			}
+			return setTasks(ctx, repo, obj)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, cid); err != nil {
+			return err
+		}
+
+		current, err := repo.GetCourseTree(ctx, cid)
+		if err != nil {
+			return err
+		}
+		matchKeys(obj, current)
+
+		if _, err := repo.UpdateCourse(ctx, obj); err != nil {
+			return err
+		}
+		if _, err := recordRevision(ctx, repo, entities.RevisionCourse, cid); err != nil {
+			return err
+		}
+
+		if err := syncThemes(ctx, repo, obj, true); err != nil {
+			return err
+		}
+		return setTasks(ctx, repo, obj)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return -1, false, fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, obj.ID)
+	log.Info("course successfully imported", slog.Int("id", obj.ID), slog.Bool("created", created))
+
+	return obj.ID, created, nil
+}
+
+// matchKeys copies the ids of current into the course, themes and lessons
+// of obj with the same external keys. Lessons are matched across the whole
+// course, so a lesson moved to another theme keeps its id.
+func matchKeys(obj, current *entities.Course) {
+	themes := make(map[string]int, len(current.Themes))
+	lessons := make(map[string]int)
+	for _, th := range current.Themes {
+		themes[th.ExternalKey] = th.ID
+		for _, ls := range th.Lessons {
+			lessons[ls.ExternalKey] = ls.ID
+		}
+	}
+
+	obj.ID = current.ID
+	for _, th := range obj.Themes {
+		th.ID = themes[th.ExternalKey]
+		for _, ls := range th.Lessons {
+			ls.ID = lessons[ls.ExternalKey]
+		}
+	}
+}
+
+// setTasks writes the typed tasks of the lessons of obj, which must have
+// been saved already.
+func setTasks(ctx context.Context, repo CourseRepo, obj *entities.Course) error {
+	for _, th := range obj.Themes {
+		for _, ls := range th.Lessons {
+			if ls.TaskSpec == nil {
+				continue
+			}
+
+			ls.TaskSpec.LessonID = ls.ID
+			ls.TaskSpec.CourseID = obj.ID
+			if ls.TaskSpec.MaxScore == 0 {
+				ls.TaskSpec.MaxScore = defaultMaxScore
+			}
+			if err := repo.SetTask(ctx, ls.TaskSpec); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var (
+	ErrAttemptNotFound = entities.NewError(entities.KindNotFound, "ATTEMPT_NOT_FOUND", "attempt not found")
+	ErrAttemptGraded   = entities.NewError(entities.KindFailedPrecondition, "ATTEMPT_ALREADY_GRADED", "only attempts pending review can be reviewed")
+	ErrInvalidScore    = entities.NewError(entities.KindInvalidArgument, "INVALID_SCORE", "score exceeds the max score of the attempt")
+)
+
+// SubmitTaskAnswer grades the caller's answer to a lesson task and stores
+// it as a new attempt. The caller has to be enrolled in the course.
+func (s *EnrollmentService) SubmitTaskAnswer(ctx context.Context, lid int, answer entities.TaskAnswer) (*entities.Attempt, error) {
+	const op = "Enrollment.SubmitTaskAnswer"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", lid),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to submit answer")
+	task, err := s.crsRepo.GetTask(ctx, lid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attempt, err := grade(task, answer)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	attempt.UserID = uid
+
+	err = s.enrRepo.WithTx(ctx, func(repo EnrollmentRepo) error {
+		if err := checkEnrolled(ctx, repo, task.CourseID, uid); err != nil {
+			return err
+		}
+		return repo.CreateAttempt(ctx, attempt)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("answer successfully submitted",
+		slog.String("status", string(attempt.Status)),
+		slog.Int("score", attempt.Score),
+	)
+
+	return attempt, nil
+}
+
+// ListAttempts returns the attempts at a lesson's task, newest first.
+// Students see their own attempts. Co-authors of the course and admins may
+// ask for one student by uid or, with all set, for every student.
+func (s *EnrollmentService) ListAttempts(ctx context.Context, lid, uid int, all bool) ([]*entities.Attempt, error) {
+	const op = "Enrollment.ListAttempts"
+
+	caller := auth.UserID(ctx)
+	if uid == 0 && !all {
+		uid = caller
+	}
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", lid),
+		slog.Int("uid", uid),
+	)
+
+	if caller == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to get attempts")
+	if all || uid != caller {
+		lesson, err := s.crsRepo.GetLesson(ctx, lid)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		role, err := authorRole(ctx, s.crsRepo, lesson.CourseID)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if role == "" {
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	if all {
+		uid = 0
+	}
+
+	attempts, err := s.enrRepo.GetAttempts(ctx, lid, uid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("attempts successfully geted")
+
+	return attempts, nil
+}
+
+// ReviewAttempt scores an attempt that could not be graded automatically.
+// Only editors of the course may review, and only once.
+func (s *EnrollmentService) ReviewAttempt(ctx context.Context, id, score int) (*entities.Attempt, error) {
+	const op = "Enrollment.ReviewAttempt"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("id", id),
+		slog.Int("score", score),
+	)
+
+	log.Info("trying to review attempt")
+	attempt, err := s.enrRepo.GetAttempt(ctx, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkEditor(ctx, s.crsRepo, attempt.CourseID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if attempt.Status != entities.AttemptPendingReview {
+		return nil, fmt.Errorf("%s: %w", op, ErrAttemptGraded)
+	}
+	if score > attempt.MaxScore {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidScore.WithField("score", fmt.Sprintf("must be at most %d", attempt.MaxScore)))
+	}
+
+	// GradeAttempt only updates attempts still pending review, so two
+	// reviewers racing cannot both score the same attempt.
+	attempt.Score = score
+	if err := s.enrRepo.GradeAttempt(ctx, attempt); err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("attempt successfully reviewed")
+
+	return attempt, nil
+}
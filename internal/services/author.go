@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var (
+	ErrCoAuthorNotFound  = entities.NewError(entities.KindNotFound, "CO_AUTHOR_NOT_FOUND", "user is not a co-author of the course")
+	ErrOwnerRoleLocked   = entities.NewError(entities.KindFailedPrecondition, "OWNER_ROLE_LOCKED", "the owner of a course cannot be changed or removed")
+	ErrInvalidAuthorRole = entities.NewError(entities.KindInvalidArgument, "INVALID_AUTHOR_ROLE", "co-authors can only be added as editors or reviewers")
+)
+
+// AddCoAuthor gives a user a role in the course, or changes the role the
+// user already has. Only the owner may manage co-authors.
+func (s *CourseService) AddCoAuthor(ctx context.Context, obj *entities.CoAuthor) error {
+	const op = "Course.AddCoAuthor"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", obj.CourseID),
+		slog.Int("uid", obj.UserID),
+		slog.String("role", string(obj.Role)),
+	)
+
+	if obj.Role != entities.AuthorEditor && obj.Role != entities.AuthorReviewer {
+		return fmt.Errorf("%s: %w", op, ErrInvalidAuthorRole)
+	}
+
+	log.Info("trying to add co-author")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if _, err := repo.GetCourse(ctx, obj.CourseID); err != nil {
+			return err
+		}
+
+		if err := checkOwner(ctx, repo, obj.CourseID); err != nil {
+			return err
+		}
+
+		return repo.AddCoAuthor(ctx, obj)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("co-author successfully added")
+
+	return nil
+}
+
+func (s *CourseService) RemoveCoAuthor(ctx context.Context, cid, uid int) error {
+	const op = "Course.RemoveCoAuthor"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+		slog.Int("uid", uid),
+	)
+
+	log.Info("trying to remove co-author")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if err := checkOwner(ctx, repo, cid); err != nil {
+			return err
+		}
+
+		role, err := repo.GetAuthorRole(ctx, cid, uid)
+		if err != nil {
+			return err
+		}
+		if role == entities.AuthorOwner {
+			return ErrOwnerRoleLocked
+		}
+
+		return repo.RemoveCoAuthor(ctx, cid, uid)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("co-author successfully removed")
+
+	return nil
+}
+
+// ListCoAuthors returns everybody working on the course. Only its co-authors
+// and admins may see the list.
+func (s *CourseService) ListCoAuthors(ctx context.Context, cid int) ([]*entities.CoAuthor, error) {
+	const op = "Course.ListCoAuthors"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+	)
+
+	log.Info("trying to get co-authors")
+	role, err := authorRole(ctx, s.crsRepo, cid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if role == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	authors, err := s.crsRepo.GetCoAuthors(ctx, cid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("co-authors successfully geted")
+
+	return authors, nil
+}
+
+// ListMyCourses returns the courses the caller owns or co-authors.
+func (s *CourseService) ListMyCourses(ctx context.Context) ([]*entities.Course, error) {
+	const op = "Course.ListMyCourses"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("trying to get courses of the caller")
+	courses, err := s.crsRepo.GetAuthorCourses(ctx, uid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("courses of the caller successfully geted")
+
+	return courses, nil
+}
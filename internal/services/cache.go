@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+)
+
+// invalidate drops the cached trees of the given courses and the cached
+// catalog. A failure only leaves stale entries until they expire, so it is
+// logged instead of failing the write that already went through.
+func (s *CourseService) invalidate(ctx context.Context, cids ...int) {
+	if err := s.cache.Invalidate(ctx, cids...); err != nil {
+		s.log.Warn("failed to invalidate course cache",
+			slog.Any("cids", cids),
+			slog.String("error", err.Error()))
+	}
+}
@@ -2,48 +2,117 @@ package services
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"time"
 
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
 	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
 )
 
 var (
-	ErrCourseAlreadyExists = errors.New("course with this data already exists")
-	ErrThemeAlreadyExists  = errors.New("theme with this data already exists")
-	ErrLessonAlreadyExists = errors.New("lesson with this data already exists")
+	ErrCourseAlreadyExists = entities.NewError(entities.KindAlreadyExists, "COURSE_ALREADY_EXISTS", "course with this data already exists")
+	ErrThemeAlreadyExists  = entities.NewError(entities.KindAlreadyExists, "THEME_ALREADY_EXISTS", "theme with this data already exists")
+	ErrLessonAlreadyExists = entities.NewError(entities.KindAlreadyExists, "LESSON_ALREADY_EXISTS", "lesson with this data already exists")
+	ErrCourseNotFound      = entities.NewError(entities.KindNotFound, "COURSE_NOT_FOUND", "course not found")
+	ErrThemeNotFound       = entities.NewError(entities.KindNotFound, "THEME_NOT_FOUND", "theme not found")
+	ErrLessonNotFound      = entities.NewError(entities.KindNotFound, "LESSON_NOT_FOUND", "lesson not found")
+	ErrInvalidPageToken    = entities.NewError(entities.KindInvalidArgument, "INVALID_PAGE_TOKEN", "page token is invalid or does not match the request")
+	ErrThemeNotInCourse    = entities.NewError(entities.KindInvalidArgument, "THEME_NOT_IN_COURSE", "theme does not belong to the course or is listed twice")
+	ErrLessonNotInCourse   = entities.NewError(entities.KindInvalidArgument, "LESSON_NOT_IN_COURSE", "lesson does not belong to the course or is listed twice")
+	ErrInvalidFieldMask    = entities.NewError(entities.KindInvalidArgument, "INVALID_FIELD_MASK", "update mask contains a field that cannot be updated or repeats one")
+	ErrInvalidOrder        = entities.NewError(entities.KindInvalidArgument, "INVALID_ORDER", "order must list every theme of the course exactly once, each lesson at most once and every lesson of a reordered theme")
+	ErrInvalidDurationSpan = entities.NewError(entities.KindInvalidArgument, "INVALID_DURATION_RANGE", "min_duration must not exceed max_duration")
+)
+
+// Fields that callers may list in an update mask, named as in the proto
+// definition.
+var (
+	courseMaskFields = []string{"title", "description", "full_description", "work", "difficulty", "duration_override", "image", themesField}
+	themeMaskFields  = []string{"title", "duration_override"}
+	lessonMaskFields = []string{"title", "duration", "body", "task"}
+)
+
+const themesField = "themes"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
 )
 
 type CourseService struct {
 	log     *slog.Logger
 	crsRepo CourseRepo
+	cache   CourseCache
+}
+
+// CourseCache drops cached reads of courses. The service calls it after
+// every committed write.
+type CourseCache interface {
+	Invalidate(ctx context.Context, cids ...int) error
 }
 
 type CourseRepo interface {
+	WithTx(ctx context.Context, fn func(repo CourseRepo) error) error
 	Create(ctx context.Context, course *entities.Course) (id int, err error)
 	CreateTheme(ctx context.Context, theme *entities.Theme) (id int, err error)
 	CreateLesson(ctx context.Context, lesson *entities.Lesson) (id int, err error)
-	GetAllCourses(ctx context.Context) ([]*entities.Course, error)
+	GetAllCourses(ctx context.Context, statuses ...entities.CourseStatus) ([]*entities.Course, error)
+	ListCourses(ctx context.Context, params entities.CourseListParams) (*entities.CoursePage, error)
+	SearchCourses(ctx context.Context, params entities.SearchParams) (*entities.SearchResult, error)
 	GetCourse(ctx context.Context, id int) (*entities.Course, error)
+	GetCourseIDByKey(ctx context.Context, key string) (int, error)
+	GetCourseTree(ctx context.Context, id int) (*entities.Course, error)
+	StreamCourses(ctx context.Context, fn func(*entities.Course) error, statuses ...entities.CourseStatus) error
+	StreamCourseContent(ctx context.Context, cid int, fn func(*entities.CourseContent) error) error
 	GetThemes(ctx context.Context, cid int) ([]*entities.Theme, error)
 	GetLessons(ctx context.Context, cid, tid int) ([]*entities.Lesson, error)
+	GetTheme(ctx context.Context, id int) (*entities.Theme, error)
+	GetLesson(ctx context.Context, id int) (*entities.Lesson, error)
+	GetUnrenderedLessons(ctx context.Context, after, limit int) ([]*entities.Lesson, error)
+	SetLessonBody(ctx context.Context, obj *entities.Lesson) error
 	DeleteCourse(ctx context.Context, id int) (err error)
-	UpdateCourse(ctx context.Context, obj *entities.Course) (id int, err error)
-	UpdateTheme(ctx context.Context, obj *entities.Theme) (id int, err error)
-	UpdateLesson(ctx context.Context, obj *entities.Lesson) (id int, err error)
+	UpdateCourse(ctx context.Context, obj *entities.Course, fields ...string) (id int, err error)
+	UpdateTheme(ctx context.Context, obj *entities.Theme, fields ...string) (id int, err error)
+	UpdateLesson(ctx context.Context, obj *entities.Lesson, fields ...string) (id int, err error)
+	DeleteTheme(ctx context.Context, id int) error
+	DeleteLesson(ctx context.Context, id int) error
+	ReorderThemes(ctx context.Context, cid int, ids []int) error
+	ReorderLessons(ctx context.Context, cid, tid int, ids []int) error
+	SetCourseStatus(ctx context.Context, t *entities.StatusTransition) error
+	RestoreCourse(ctx context.Context, id int) error
+	GetDeletedCourses(ctx context.Context) ([]*entities.Course, error)
+	AddCoAuthor(ctx context.Context, obj *entities.CoAuthor) error
+	RemoveCoAuthor(ctx context.Context, cid, uid int) error
+	GetCoAuthors(ctx context.Context, cid int) ([]*entities.CoAuthor, error)
+	GetAuthorRole(ctx context.Context, cid, uid int) (entities.AuthorRole, error)
+	GetAuthorCourses(ctx context.Context, uid int) ([]*entities.Course, error)
+	SetTask(ctx context.Context, obj *entities.Task) error
+	GetTask(ctx context.Context, lid int) (*entities.Task, error)
+	GetCourseTasks(ctx context.Context, cid int) ([]*entities.Task, error)
+	PurgeCourses(ctx context.Context, before time.Time) (int64, error)
+	CreateRevision(ctx context.Context, rev *entities.Revision) error
+	GetRevisions(ctx context.Context, entity entities.RevisionEntity, id int) ([]*entities.Revision, error)
+	GetRevision(ctx context.Context, entity entities.RevisionEntity, id, version int) (*entities.Revision, error)
 }
 
 func NewCourseService(
 	log *slog.Logger,
 	crsRepo CourseRepo,
+	cache CourseCache,
 ) *CourseService {
 	return &CourseService{
 		log:     log,
 		crsRepo: crsRepo,
+		cache:   cache,
 	}
 }
 
+// Create stores the course together with its themes and lessons in a single
+// transaction. Generated ids are written back into obj and its children.
 func (s *CourseService) Create(ctx context.Context, obj *entities.Course) (int, error) {
 	const op = "Course.Create"
 
@@ -51,51 +120,194 @@ func (s *CourseService) Create(ctx context.Context, obj *entities.Course) (int,
 		slog.String("op", op),
 	)
 
+	if err := validation.Course(obj); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderThemes(obj.Themes); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	obj.Status = entities.StatusDraft
+	obj.OwnerID = auth.UserID(ctx)
+
 	log.Info("trying to create course")
-	id, err := s.crsRepo.Create(ctx, obj)
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		return createCourse(ctx, repo, obj)
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
+	s.invalidate(ctx, obj.ID)
 	log.Info("successfully created course")
 
-	return id, err
+	return obj.ID, nil
+}
+
+// createCourse stores obj with its themes and lessons through repo, which
+// is expected to be bound to a transaction.
+func createCourse(ctx context.Context, repo CourseRepo, obj *entities.Course) error {
+	id, err := repo.Create(ctx, obj)
+	if err != nil {
+		return err
+	}
+	obj.ID = id
+
+	if obj.OwnerID != 0 {
+		owner := &entities.CoAuthor{CourseID: id, UserID: obj.OwnerID, Role: entities.AuthorOwner}
+		if err := repo.AddCoAuthor(ctx, owner); err != nil {
+			return err
+		}
+	}
+
+	if _, err := recordRevision(ctx, repo, entities.RevisionCourse, id); err != nil {
+		return err
+	}
+
+	for i, theme := range obj.Themes {
+		theme.CourseID = id
+		theme.Position = i
+		theme.ID, err = repo.CreateTheme(ctx, theme)
+		if err != nil {
+			return err
+		}
+
+		for j, lesson := range theme.Lessons {
+			lesson.CourseID = id
+			lesson.ThemeID = theme.ID
+			lesson.Position = j
+			lesson.ID, err = repo.CreateLesson(ctx, lesson)
+			if err != nil {
+				return err
+			}
+
+			if _, err := recordRevision(ctx, repo, entities.RevisionLesson, lesson.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
+// CreateTheme appends a theme, together with its lessons, to the end of
+// the course.
 func (s *CourseService) CreateTheme(ctx context.Context, obj *entities.Theme) (int, error) {
 	const op = "Course.CreateTheme"
 
 	log := s.log.With(
 		slog.String("op", op),
+		slog.Int("cid", obj.CourseID),
 	)
 
+	if err := validation.Theme(obj); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderThemes([]*entities.Theme{obj}); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
 	log.Info("trying to create theme")
-	id, err := s.crsRepo.CreateTheme(ctx, obj)
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if _, err := repo.GetCourse(ctx, obj.CourseID); err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, obj.CourseID); err != nil {
+			return err
+		}
+
+		themes, err := repo.GetThemes(ctx, obj.CourseID)
+		if err != nil {
+			return err
+		}
+
+		obj.Position = nextPosition(themes, func(th *entities.Theme) int { return th.Position })
+		obj.ID, err = repo.CreateTheme(ctx, obj)
+		if err != nil {
+			return err
+		}
+
+		for i, lesson := range obj.Lessons {
+			lesson.CourseID = obj.CourseID
+			lesson.ThemeID = obj.ID
+			lesson.Position = i
+			lesson.ID, err = repo.CreateLesson(ctx, lesson)
+			if err != nil {
+				return err
+			}
+
+			if _, err := recordRevision(ctx, repo, entities.RevisionLesson, lesson.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
+	s.invalidate(ctx, obj.CourseID)
 	log.Info("successfully created theme")
 
-	return id, err
+	return obj.ID, nil
 }
 
+// CreateLesson appends a lesson to the end of obj.ThemeID. The course is
+// taken from the theme.
 func (s *CourseService) CreateLesson(ctx context.Context, obj *entities.Lesson) (int, error) {
 	const op = "Course.CreateLesson"
 
 	log := s.log.With(
 		slog.String("op", op),
+		slog.Int("tid", obj.ThemeID),
 	)
 
+	if err := validation.Lesson(obj); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderLesson(obj); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
 	log.Info("trying to create lesson")
-	id, err := s.crsRepo.CreateLesson(ctx, obj)
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		theme, err := repo.GetTheme(ctx, obj.ThemeID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, theme.CourseID); err != nil {
+			return err
+		}
+
+		lessons, err := repo.GetLessons(ctx, theme.CourseID, theme.ID)
+		if err != nil {
+			return err
+		}
+
+		obj.CourseID = theme.CourseID
+		obj.Position = nextPosition(lessons, func(ls *entities.Lesson) int { return ls.Position })
+		obj.ID, err = repo.CreateLesson(ctx, obj)
+		if err != nil {
+			return err
+		}
+
+		_, err = recordRevision(ctx, repo, entities.RevisionLesson, obj.ID)
+		return err
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
+	s.invalidate(ctx, obj.CourseID)
 	log.Info("successfully created lesson")
 
-	return id, err
+	return obj.ID, nil
 }
 
 func (s *CourseService) GetAllCourses(ctx context.Context) ([]*entities.Course, error) {
@@ -106,7 +318,7 @@ func (s *CourseService) GetAllCourses(ctx context.Context) ([]*entities.Course,
 	)
 
 	log.Info("trying to get courses")
-	courses, err := s.crsRepo.GetAllCourses(ctx)
+	courses, err := s.crsRepo.GetAllCourses(ctx, visibleStatuses(ctx)...)
 	if err != nil {
 		log.Error(err.Error())
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -116,61 +328,97 @@ func (s *CourseService) GetAllCourses(ctx context.Context) ([]*entities.Course,
 	return courses, err
 }
 
-func (s *CourseService) GetCourse(ctx context.Context, id int) (*entities.Course, error) {
-	const op = "Course.GetCourse"
+// ListCourses returns one page of the catalog. Missing page size and sort
+// field are filled with defaults before the repository is queried.
+func (s *CourseService) ListCourses(ctx context.Context, params entities.CourseListParams) (*entities.CoursePage, error) {
+	const op = "Course.ListCourses"
 
 	log := s.log.With(
 		slog.String("op", op),
-		slog.Int("id", id),
 	)
 
-	log.Info("trying to get course")
-	course, err := s.crsRepo.GetCourse(ctx, id)
+	if params.Limit <= 0 {
+		params.Limit = defaultPageSize
+	}
+	if params.Limit > maxPageSize {
+		params.Limit = maxPageSize
+	}
+	if params.SortBy == "" {
+		params.SortBy = entities.SortByCreatedAt
+	}
+	if !auth.FromContext(ctx).HasRole(auth.RoleAdmin) {
+		params.Statuses = visibleStatuses(ctx)
+	}
+	if params.MinDuration > 0 && params.MaxDuration > 0 && params.MinDuration > params.MaxDuration {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidDurationSpan)
+	}
+
+	log.Info("trying to list courses")
+	page, err := s.crsRepo.ListCourses(ctx, params)
 	if err != nil {
 		log.Error(err.Error())
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	log.Info("course successfully geted")
+	log.Info("courses successfully listed")
 
-	return course, err
+	return page, nil
 }
 
-func (s *CourseService) GetThemes(ctx context.Context, cid int) ([]*entities.Theme, error) {
-	const op = "Course.GetThemes"
+func (s *CourseService) SearchCourses(ctx context.Context, params entities.SearchParams) (*entities.SearchResult, error) {
+	const op = "Course.SearchCourses"
 
 	log := s.log.With(
 		slog.String("op", op),
-		slog.Int("cid", cid),
+		slog.String("query", params.Query),
 	)
 
-	log.Info("trying to get themes")
-	themes, err := s.crsRepo.GetThemes(ctx, cid)
+	if params.Limit <= 0 {
+		params.Limit = defaultPageSize
+	}
+	if params.Limit > maxPageSize {
+		params.Limit = maxPageSize
+	}
+
+	params.Statuses = visibleStatuses(ctx)
+
+	log.Info("trying to search courses")
+	res, err := s.crsRepo.SearchCourses(ctx, params)
 	if err != nil {
 		log.Error(err.Error())
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	log.Info("themes successfully geted")
+	log.Info("courses successfully searched")
 
-	return themes, err
+	return res, nil
 }
 
-func (s *CourseService) GetLessons(ctx context.Context, cid, tid int) ([]*entities.Lesson, error) {
-	const op = "Course.GetLessons"
+// GetCourseTree returns a course together with its themes and lessons.
+func (s *CourseService) GetCourseTree(ctx context.Context, id int) (*entities.Course, error) {
+	const op = "Course.GetCourseTree"
 
 	log := s.log.With(
 		slog.String("op", op),
-		slog.Int("cid", cid),
+		slog.Int("id", id),
 	)
 
-	log.Info("trying to get lessons")
-	lessons, err := s.crsRepo.GetLessons(ctx, cid, tid)
+	log.Info("trying to get course tree")
+	course, err := s.crsRepo.GetCourseTree(ctx, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	visible, err := isVisible(ctx, s.crsRepo, course)
 	if err != nil {
 		log.Error(err.Error())
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	log.Info("lessons successfully geted")
+	if !visible {
+		return nil, fmt.Errorf("%s: %w", op, ErrCourseNotFound)
+	}
+	log.Info("course tree successfully geted")
 
-	return lessons, err
+	return course, nil
 }
 
 func (s *CourseService) DeleteCourse(ctx context.Context, cid int) error {
@@ -182,66 +430,518 @@ func (s *CourseService) DeleteCourse(ctx context.Context, cid int) error {
 	)
 
 	log.Info("trying to delete course")
-	err := s.crsRepo.DeleteCourse(ctx, cid)
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if err := checkOwner(ctx, repo, cid); err != nil {
+			return err
+		}
+		return repo.DeleteCourse(ctx, cid)
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	s.invalidate(ctx, cid)
 	log.Info("course successfully deleted")
 
 	return nil
 }
 
-func (s *CourseService) UpdateCourse(ctx context.Context, obj *entities.Course) (int, error) {
+// UpdateCourse updates the course and its syllabus in one transaction.
+// Themes and lessons without an id are created, the others are updated and
+// must already belong to the course. With opts.Replace set, themes and
+// lessons that are missing from obj are deleted. A non-empty opts.Fields
+// limits the update to the listed fields; the syllabus is only touched when
+// it includes "themes". The course and every written lesson get a new
+// revision.
+func (s *CourseService) UpdateCourse(ctx context.Context, obj *entities.Course, opts entities.UpdateOptions) (int, error) {
 	const op = "Course.UpdateCourse"
 
 	log := s.log.With(
 		slog.String("op", op),
+		slog.Int("cid", obj.ID),
+		slog.Bool("replace", opts.Replace),
+		slog.Any("fields", opts.Fields),
 	)
 
+	if err := checkMask(opts.Fields, courseMaskFields); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := validation.Course(obj, opts.Fields...); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderThemes(obj.Themes); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	courseFields := slices.DeleteFunc(slices.Clone(opts.Fields), func(f string) bool { return f == themesField })
+	withThemes := len(opts.Fields) == 0 || slices.Contains(opts.Fields, themesField)
+
 	log.Info("trying to update course")
-	id, err := s.crsRepo.UpdateCourse(ctx, obj)
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if err := checkEditor(ctx, repo, obj.ID); err != nil {
+			return err
+		}
+
+		var err error
+		if len(opts.Fields) > 0 && len(courseFields) == 0 {
+			_, err = repo.GetCourse(ctx, obj.ID)
+		} else {
+			_, err = repo.UpdateCourse(ctx, obj, courseFields...)
+			if err == nil {
+				_, err = recordRevision(ctx, repo, entities.RevisionCourse, obj.ID)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if !withThemes {
+			return nil
+		}
+
+		return syncThemes(ctx, repo, obj, opts.Replace)
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
+	s.invalidate(ctx, obj.ID)
 	log.Info("successfully updated course")
 
-	return id, err
+	return obj.ID, nil
 }
 
-func (s *CourseService) UpdateTheme(ctx context.Context, obj *entities.Theme) (int, error) {
+// syncThemes writes the themes and lessons of obj in their order: the ones
+// with an id are updated, the others created. With replace, themes and
+// lessons of the course missing from obj are deleted.
+func syncThemes(ctx context.Context, repo CourseRepo, obj *entities.Course, replace bool) error {
+	themes, err := repo.GetThemes(ctx, obj.ID)
+	if err != nil {
+		return err
+	}
+
+	oldThemes := make(map[int]bool, len(themes))
+	oldLessons := make(map[int]bool)
+	for _, th := range themes {
+		oldThemes[th.ID] = true
+
+		lessons, err := repo.GetLessons(ctx, obj.ID, th.ID)
+		if err != nil {
+			return err
+		}
+		for _, ls := range lessons {
+			oldLessons[ls.ID] = true
+		}
+	}
+
+	keptThemes := make(map[int]bool, len(obj.Themes))
+	keptLessons := make(map[int]bool, len(oldLessons))
+	for i, theme := range obj.Themes {
+		theme.CourseID = obj.ID
+		theme.Position = i
+
+		if theme.ID == 0 {
+			theme.ID, err = repo.CreateTheme(ctx, theme)
+		} else {
+			if !oldThemes[theme.ID] || keptThemes[theme.ID] {
+				return ErrThemeNotInCourse.WithMetadata("theme_id", strconv.Itoa(theme.ID))
+			}
+			keptThemes[theme.ID] = true
+			_, err = repo.UpdateTheme(ctx, theme)
+		}
+		if err != nil {
+			return err
+		}
+
+		for j, lesson := range theme.Lessons {
+			lesson.CourseID = obj.ID
+			lesson.ThemeID = theme.ID
+			lesson.Position = j
+
+			if lesson.ID == 0 {
+				lesson.ID, err = repo.CreateLesson(ctx, lesson)
+			} else {
+				if !oldLessons[lesson.ID] || keptLessons[lesson.ID] {
+					return ErrLessonNotInCourse.WithMetadata("lesson_id", strconv.Itoa(lesson.ID))
+				}
+				keptLessons[lesson.ID] = true
+				_, err = repo.UpdateLesson(ctx, lesson)
+			}
+			if err != nil {
+				return err
+			}
+
+			if _, err := recordRevision(ctx, repo, entities.RevisionLesson, lesson.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !replace {
+		return nil
+	}
+
+	for id := range oldLessons {
+		if !keptLessons[id] {
+			if err := repo.DeleteLesson(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	for id := range oldThemes {
+		if !keptThemes[id] {
+			if err := repo.DeleteTheme(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateTheme writes the given fields of a theme, or all of its editable
+// fields when fields is empty. Use Reorder to move a theme.
+func (s *CourseService) UpdateTheme(ctx context.Context, obj *entities.Theme, fields []string) (int, error) {
 	const op = "Course.UpdateTheme"
 
 	log := s.log.With(
 		slog.String("op", op),
+		slog.Int("tid", obj.ID),
 	)
 
+	if err := checkMask(fields, themeMaskFields); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(fields) == 0 {
+		fields = themeMaskFields
+	}
+
+	if err := validation.Theme(obj, fields...); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
 	log.Info("trying to update theme")
-	id, err := s.crsRepo.UpdateTheme(ctx, obj)
+	var cid int
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		var err error
+		cid, err = checkThemeEditor(ctx, repo, obj.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = repo.UpdateTheme(ctx, obj, fields...)
+		return err
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
-	log.Info("successfully created theme")
+	s.invalidate(ctx, cid)
+	log.Info("successfully updated theme")
 
-	return id, err
+	return obj.ID, nil
 }
 
-func (s *CourseService) UpdateLesson(ctx context.Context, obj *entities.Lesson) (int, error) {
+// UpdateLesson writes the given fields of a lesson, or all of its editable
+// fields when fields is empty, and records the result as a new revision.
+// Use Reorder to move a lesson.
+func (s *CourseService) UpdateLesson(ctx context.Context, obj *entities.Lesson, fields []string) (int, error) {
 	const op = "Course.UpdateLesson"
 
 	log := s.log.With(
 		slog.String("op", op),
+		slog.Int("lid", obj.ID),
 	)
 
+	if err := checkMask(fields, lessonMaskFields); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(fields) == 0 {
+		fields = lessonMaskFields
+	}
+
+	if err := validation.Lesson(obj, fields...); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderLesson(obj); err != nil {
+		return -1, fmt.Errorf("%s: %w", op, err)
+	}
+
 	log.Info("trying to update lesson")
-	id, err := s.crsRepo.UpdateLesson(ctx, obj)
+	var cid int
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		var err error
+		cid, err = checkLessonEditor(ctx, repo, obj.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = repo.UpdateLesson(ctx, obj, fields...)
+		if err != nil {
+			return err
+		}
+
+		_, err = recordRevision(ctx, repo, entities.RevisionLesson, obj.ID)
+		return err
+	})
 	if err != nil {
 		log.Error(err.Error())
 		return -1, fmt.Errorf("%s: %w", op, err)
 	}
+	s.invalidate(ctx, cid)
 	log.Info("successfully updated lesson")
 
-	return id, err
+	return obj.ID, nil
+}
+
+// GetTheme returns a theme together with its lessons.
+func (s *CourseService) GetTheme(ctx context.Context, id int) (*entities.Theme, error) {
+	const op = "Course.GetTheme"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("tid", id),
+	)
+
+	log.Info("trying to get theme")
+	theme, err := s.crsRepo.GetTheme(ctx, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVisible(ctx, s.crsRepo, theme.CourseID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	theme.Lessons, err = s.crsRepo.GetLessons(ctx, theme.CourseID, theme.ID)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("theme successfully geted")
+
+	return theme, nil
+}
+
+func (s *CourseService) GetLesson(ctx context.Context, id int) (*entities.Lesson, error) {
+	const op = "Course.GetLesson"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", id),
+	)
+
+	log.Info("trying to get lesson")
+	lesson, err := s.crsRepo.GetLesson(ctx, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVisible(ctx, s.crsRepo, lesson.CourseID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("lesson successfully geted")
+
+	return lesson, nil
+}
+
+func (s *CourseService) DeleteTheme(ctx context.Context, id int) error {
+	const op = "Course.DeleteTheme"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("tid", id),
+	)
+
+	log.Info("trying to delete theme")
+	var cid int
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		var err error
+		cid, err = checkThemeEditor(ctx, repo, id)
+		if err != nil {
+			return err
+		}
+		return repo.DeleteTheme(ctx, id)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, cid)
+	log.Info("theme successfully deleted")
+
+	return nil
+}
+
+func (s *CourseService) DeleteLesson(ctx context.Context, id int) error {
+	const op = "Course.DeleteLesson"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", id),
+	)
+
+	log.Info("trying to delete lesson")
+	var cid int
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		var err error
+		cid, err = checkLessonEditor(ctx, repo, id)
+		if err != nil {
+			return err
+		}
+		return repo.DeleteLesson(ctx, id)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, cid)
+	log.Info("lesson successfully deleted")
+
+	return nil
+}
+
+// Reorder applies a new syllabus order atomically. Theme ids must be a
+// permutation of the course's themes; lessons are moved into the theme they
+// are listed under, and each listed theme must list all of its lessons.
+func (s *CourseService) Reorder(ctx context.Context, order *entities.SyllabusOrder) error {
+	const op = "Course.Reorder"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", order.CourseID),
+	)
+
+	log.Info("trying to reorder course")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if _, err := repo.GetCourse(ctx, order.CourseID); err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, order.CourseID); err != nil {
+			return err
+		}
+
+		tree, err := repo.GetCourseTree(ctx, order.CourseID)
+		if err != nil {
+			return err
+		}
+
+		courseThemes := make(map[int]bool, len(tree.Themes))
+		for _, th := range tree.Themes {
+			courseThemes[th.ID] = true
+		}
+
+		if len(order.ThemeIDs) > 0 {
+			if !isPermutation(courseThemes, order.ThemeIDs) {
+				return ErrInvalidOrder
+			}
+			if err := repo.ReorderThemes(ctx, order.CourseID, order.ThemeIDs); err != nil {
+				return err
+			}
+		}
+
+		seenThemes := make(map[int]bool, len(order.Lessons))
+		seenLessons := make(map[int]bool)
+		for _, lo := range order.Lessons {
+			if !courseThemes[lo.ThemeID] {
+				return ErrThemeNotFound.WithMetadata("theme_id", strconv.Itoa(lo.ThemeID))
+			}
+			if seenThemes[lo.ThemeID] {
+				return ErrInvalidOrder
+			}
+			seenThemes[lo.ThemeID] = true
+
+			for _, id := range lo.LessonIDs {
+				if seenLessons[id] {
+					return ErrInvalidOrder
+				}
+				seenLessons[id] = true
+			}
+		}
+
+		// A listed theme must keep every lesson that is not moved to
+		// another listed theme, or positions would collide. Themes that
+		// lessons were pulled from without being listed are renumbered.
+		var rest []entities.LessonOrder
+		for _, th := range tree.Themes {
+			var left []int
+			for _, l := range th.Lessons {
+				if !seenLessons[l.ID] {
+					left = append(left, l.ID)
+				}
+			}
+			switch {
+			case seenThemes[th.ID] && len(left) > 0:
+				return ErrInvalidOrder.WithMetadata("theme_id", strconv.Itoa(th.ID))
+			case !seenThemes[th.ID] && len(left) < len(th.Lessons) && len(left) > 0:
+				rest = append(rest, entities.LessonOrder{ThemeID: th.ID, LessonIDs: left})
+			}
+		}
+
+		for _, lo := range append(order.Lessons, rest...) {
+			if err := repo.ReorderLessons(ctx, order.CourseID, lo.ThemeID, lo.LessonIDs); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, order.CourseID)
+	log.Info("course successfully reordered")
+
+	return nil
+}
+
+// checkMask rejects update masks that name a field outside of allowed or
+// name one twice, which would set the column twice.
+func checkMask(fields, allowed []string) error {
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if !slices.Contains(allowed, f) {
+			return ErrInvalidFieldMask.WithMetadata("path", f)
+		}
+		if seen[f] {
+			return ErrInvalidFieldMask.WithMetadata("path", f)
+		}
+		seen[f] = true
+	}
+	return nil
+}
+
+// nextPosition returns the position right after the last of items.
+func nextPosition[T any](items []T, position func(T) int) int {
+	next := 0
+	for _, it := range items {
+		if p := position(it); p >= next {
+			next = p + 1
+		}
+	}
+	return next
+}
+
+func isPermutation(set map[int]bool, ids []int) bool {
+	if len(set) != len(ids) {
+		return false
+	}
+
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !set[id] || seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+
+	return true
 }
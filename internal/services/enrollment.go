@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var (
+	ErrEnrollmentNotFound  = entities.NewError(entities.KindNotFound, "ENROLLMENT_NOT_FOUND", "not enrolled in this course")
+	ErrAlreadyEnrolled     = entities.NewError(entities.KindAlreadyExists, "ALREADY_ENROLLED", "already enrolled in this course")
+	ErrEnrollmentNotActive = entities.NewError(entities.KindFailedPrecondition, "ENROLLMENT_NOT_ACTIVE", "enrollment is not active")
+	ErrCourseNotPublished  = entities.NewError(entities.KindFailedPrecondition, "COURSE_NOT_PUBLISHED", "only published courses are open for enrollment")
+	ErrUnauthenticated     = entities.NewError(entities.KindUnauthenticated, "UNAUTHENTICATED", "the caller has to be signed in")
+)
+
+type EnrollmentService struct {
+	log     *slog.Logger
+	enrRepo EnrollmentRepo
+	crsRepo CourseRepo
+}
+
+type EnrollmentRepo interface {
+	WithTx(ctx context.Context, fn func(repo EnrollmentRepo) error) error
+	Enroll(ctx context.Context, obj *entities.Enrollment) error
+	GetEnrollment(ctx context.Context, cid, uid int) (*entities.Enrollment, error)
+	SetEnrollmentStatus(ctx context.Context, obj *entities.Enrollment) error
+	GetUserEnrollments(ctx context.Context, uid int, statuses ...entities.EnrollmentStatus) ([]*entities.Enrollment, error)
+	GetCourseEnrollments(ctx context.Context, cid int, statuses ...entities.EnrollmentStatus) ([]*entities.Enrollment, error)
+	StartLesson(ctx context.Context, obj *entities.LessonProgress) error
+	CompleteLesson(ctx context.Context, obj *entities.LessonProgress) error
+	GetThemeProgress(ctx context.Context, cid, uid int) ([]*entities.ThemeProgress, error)
+	CreateAttempt(ctx context.Context, obj *entities.Attempt) error
+	GetAttempts(ctx context.Context, lid, uid int) ([]*entities.Attempt, error)
+	GetAttempt(ctx context.Context, id int) (*entities.Attempt, error)
+	GradeAttempt(ctx context.Context, obj *entities.Attempt) error
+}
+
+func NewEnrollmentService(
+	log *slog.Logger,
+	enrRepo EnrollmentRepo,
+	crsRepo CourseRepo,
+) *EnrollmentService {
+	return &EnrollmentService{
+		log:     log,
+		enrRepo: enrRepo,
+		crsRepo: crsRepo,
+	}
+}
+
+// Enroll signs the caller up for a published course. A student who dropped
+// the course before is enrolled again.
+func (s *EnrollmentService) Enroll(ctx context.Context, cid int) (*entities.Enrollment, error) {
+	const op = "Enrollment.Enroll"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to enroll")
+	course, err := s.crsRepo.GetCourse(ctx, cid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	visible, err := isVisible(ctx, s.crsRepo, course)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !visible {
+		return nil, fmt.Errorf("%s: %w", op, ErrCourseNotFound)
+	}
+	if course.Status != entities.StatusPublished {
+		return nil, fmt.Errorf("%s: %w", op, ErrCourseNotPublished)
+	}
+
+	enrollment := &entities.Enrollment{CourseID: cid, UserID: uid}
+	if err := s.enrRepo.Enroll(ctx, enrollment); err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("successfully enrolled")
+
+	return enrollment, nil
+}
+
+// Unenroll marks the caller's active enrollment as dropped.
+func (s *EnrollmentService) Unenroll(ctx context.Context, cid int) (*entities.Enrollment, error) {
+	const op = "Enrollment.Unenroll"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	var enrollment *entities.Enrollment
+	log.Info("trying to unenroll")
+	err := s.enrRepo.WithTx(ctx, func(repo EnrollmentRepo) error {
+		var err error
+		enrollment, err = repo.GetEnrollment(ctx, cid, uid)
+		if err != nil {
+			return err
+		}
+
+		if enrollment.Status != entities.EnrollmentActive {
+			return ErrEnrollmentNotActive.WithMetadata("status", string(enrollment.Status))
+		}
+
+		enrollment.Status = entities.EnrollmentDropped
+		return repo.SetEnrollmentStatus(ctx, enrollment)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("successfully unenrolled")
+
+	return enrollment, nil
+}
+
+// Enrollment returns the caller's enrollment in the course, or nil if the
+// caller is anonymous or has never enrolled.
+func (s *EnrollmentService) Enrollment(ctx context.Context, cid int) (*entities.Enrollment, error) {
+	const op = "Enrollment.Enrollment"
+
+	uid := auth.UserID(ctx)
+	if uid == 0 {
+		return nil, nil
+	}
+
+	enrollment, err := s.enrRepo.GetEnrollment(ctx, cid, uid)
+	if err != nil {
+		if errors.Is(err, ErrEnrollmentNotFound) {
+			return nil, nil
+		}
+		s.log.Error(err.Error(), slog.String("op", op))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return enrollment, nil
+}
+
+// ListEnrollments returns the caller's enrollments with one of statuses, or
+// all of them when statuses is empty.
+func (s *EnrollmentService) ListEnrollments(ctx context.Context, statuses []entities.EnrollmentStatus) ([]*entities.Enrollment, error) {
+	const op = "Enrollment.ListEnrollments"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to get enrollments")
+	enrollments, err := s.enrRepo.GetUserEnrollments(ctx, uid, statuses...)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("enrollments successfully geted")
+
+	return enrollments, nil
+}
+
+// ListCourseStudents returns the enrollments of a course. Only the
+// co-authors of the course and admins may see them.
+func (s *EnrollmentService) ListCourseStudents(ctx context.Context, cid int, statuses []entities.EnrollmentStatus) ([]*entities.Enrollment, error) {
+	const op = "Enrollment.ListCourseStudents"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+	)
+
+	log.Info("trying to get course students")
+	role, err := authorRole(ctx, s.crsRepo, cid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if role == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	enrollments, err := s.enrRepo.GetCourseEnrollments(ctx, cid, statuses...)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("course students successfully geted")
+
+	return enrollments, nil
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var ErrInvalidAnswer = entities.NewError(entities.KindInvalidArgument, "INVALID_ANSWER", "answer does not fit the task")
+
+// grade scores an answer. Answers that cannot be checked automatically, free
+// text without accepted answers and all code, are left for review with a
+// score of zero until an editor scores them with ReviewAttempt. The output a student reports for code is not proof it was
+// produced by the submitted source, so it is only kept for the reviewer.
+func grade(task *entities.Task, answer entities.TaskAnswer) (*entities.Attempt, error) {
+	res := &entities.Attempt{
+		LessonID: task.LessonID,
+		CourseID: task.CourseID,
+		Answer:   answer,
+		MaxScore: task.MaxScore,
+		Status:   entities.AttemptGraded,
+	}
+
+	switch task.Kind {
+	case entities.TaskSingleChoice:
+		if len(answer.Choices) != 1 || !validChoices(task.Choice, answer.Choices) {
+			return nil, ErrInvalidAnswer.WithField("choices", "must name exactly one option")
+		}
+		if answer.Choices[0] == task.Choice.Correct[0] {
+			res.Score = task.MaxScore
+		}
+	case entities.TaskMultipleChoice:
+		if len(answer.Choices) == 0 || !validChoices(task.Choice, answer.Choices) {
+			return nil, ErrInvalidAnswer.WithField("choices", "must name distinct options")
+		}
+		res.Score = choiceScore(task.Choice.Correct, answer.Choices, task.MaxScore)
+	case entities.TaskFreeText:
+		if strings.TrimSpace(answer.Text) == "" {
+			return nil, ErrInvalidAnswer.WithField("text", "must not be empty")
+		}
+		if len(task.FreeText.AcceptedAnswers) == 0 {
+			res.Status = entities.AttemptPendingReview
+			break
+		}
+		got := normalizeText(answer.Text, task.FreeText.CaseSensitive)
+		for _, want := range task.FreeText.AcceptedAnswers {
+			if got == normalizeText(want, task.FreeText.CaseSensitive) {
+				res.Score = task.MaxScore
+				break
+			}
+		}
+	case entities.TaskCode:
+		if strings.TrimSpace(answer.Source) == "" {
+			return nil, ErrInvalidAnswer.WithField("source", "must not be empty")
+		}
+		res.Status = entities.AttemptPendingReview
+	}
+
+	return res, nil
+}
+
+func validChoices(task *entities.ChoiceTask, choices []int) bool {
+	seen := make(map[int]bool, len(choices))
+	for _, c := range choices {
+		if c < 0 || c >= len(task.Options) || seen[c] {
+			return false
+		}
+		seen[c] = true
+	}
+	return true
+}
+
+// choiceScore gives a point for every right option picked and takes one
+// away for every wrong one, so ticking everything does not pay off.
+func choiceScore(correct, choices []int, maxScore int) int {
+	points := 0
+	for _, c := range choices {
+		if slices.Contains(correct, c) {
+			points++
+		} else {
+			points--
+		}
+	}
+	if points <= 0 {
+		return 0
+	}
+	return points * maxScore / len(correct)
+}
+
+func normalizeText(s string, caseSensitive bool) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if !caseSensitive {
+		s = strings.ToLower(s)
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+func TestGrade(t *testing.T) {
+	singleChoice := &entities.Task{
+		Kind:     entities.TaskSingleChoice,
+		MaxScore: 100,
+		Choice:   &entities.ChoiceTask{Options: []string{"a", "b", "c"}, Correct: []int{1}},
+	}
+	multipleChoice := &entities.Task{
+		Kind:     entities.TaskMultipleChoice,
+		MaxScore: 100,
+		Choice:   &entities.ChoiceTask{Options: []string{"a", "b", "c", "d"}, Correct: []int{0, 2}},
+	}
+	freeText := &entities.Task{
+		Kind:     entities.TaskFreeText,
+		MaxScore: 100,
+		FreeText: &entities.FreeTextTask{AcceptedAnswers: []string{"New York"}},
+	}
+	caseSensitive := &entities.Task{
+		Kind:     entities.TaskFreeText,
+		MaxScore: 100,
+		FreeText: &entities.FreeTextTask{AcceptedAnswers: []string{"New York"}, CaseSensitive: true},
+	}
+	openText := &entities.Task{
+		Kind:     entities.TaskFreeText,
+		MaxScore: 100,
+		FreeText: &entities.FreeTextTask{},
+	}
+	code := &entities.Task{
+		Kind:     entities.TaskCode,
+		MaxScore: 100,
+		Code:     &entities.CodeTask{Language: "go", ExpectedOutput: "42\n"},
+	}
+
+	tests := []struct {
+		name    string
+		task    *entities.Task
+		answer  entities.TaskAnswer
+		score   int
+		status  entities.AttemptStatus
+		invalid bool
+	}{
+		{name: "single choice right", task: singleChoice, answer: entities.TaskAnswer{Choices: []int{1}}, score: 100},
+		{name: "single choice wrong", task: singleChoice, answer: entities.TaskAnswer{Choices: []int{0}}, score: 0},
+		{name: "single choice out of range", task: singleChoice, answer: entities.TaskAnswer{Choices: []int{3}}, invalid: true},
+		{name: "single choice negative", task: singleChoice, answer: entities.TaskAnswer{Choices: []int{-1}}, invalid: true},
+		{name: "single choice two options", task: singleChoice, answer: entities.TaskAnswer{Choices: []int{0, 1}}, invalid: true},
+		{name: "single choice none", task: singleChoice, answer: entities.TaskAnswer{}, invalid: true},
+
+		{name: "multiple choice all right", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{2, 0}}, score: 100},
+		{name: "multiple choice partial", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{0}}, score: 50},
+		{name: "multiple choice wrong one cancels right one", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{0, 1}}, score: 0},
+		{name: "multiple choice penalty", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{0, 1, 2}}, score: 50},
+		{name: "multiple choice everything", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{0, 1, 2, 3}}, score: 0},
+		{name: "multiple choice only wrong", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{1, 3}}, score: 0},
+		{name: "multiple choice repeated", task: multipleChoice, answer: entities.TaskAnswer{Choices: []int{0, 0}}, invalid: true},
+		{name: "multiple choice none", task: multipleChoice, answer: entities.TaskAnswer{}, invalid: true},
+
+		{name: "free text exact", task: freeText, answer: entities.TaskAnswer{Text: "New York"}, score: 100},
+		{name: "free text folds case", task: freeText, answer: entities.TaskAnswer{Text: "new YORK"}, score: 100},
+		{name: "free text trailing whitespace", task: freeText, answer: entities.TaskAnswer{Text: "New York \t\n"}, score: 100},
+		{name: "free text inner whitespace", task: freeText, answer: entities.TaskAnswer{Text: "  New\n  York"}, score: 100},
+		{name: "free text wrong", task: freeText, answer: entities.TaskAnswer{Text: "Newark"}, score: 0},
+		{name: "free text case sensitive match", task: caseSensitive, answer: entities.TaskAnswer{Text: "New York "}, score: 100},
+		{name: "free text case sensitive mismatch", task: caseSensitive, answer: entities.TaskAnswer{Text: "new york"}, score: 0},
+		{name: "free text blank", task: freeText, answer: entities.TaskAnswer{Text: " \n"}, invalid: true},
+		{name: "free text without accepted answers", task: openText, answer: entities.TaskAnswer{Text: "anything"}, status: entities.AttemptPendingReview},
+
+		{name: "code with matching output", task: code, answer: entities.TaskAnswer{Source: "main", Output: "42\n"}, status: entities.AttemptPendingReview},
+		{name: "code without output", task: code, answer: entities.TaskAnswer{Source: "main"}, status: entities.AttemptPendingReview},
+		{name: "code without source", task: code, answer: entities.TaskAnswer{Output: "42\n"}, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := grade(tt.task, tt.answer)
+			if tt.invalid {
+				if !errors.Is(err, ErrInvalidAnswer) {
+					t.Fatalf("grade() error = %v, want %v", err, ErrInvalidAnswer)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("grade() error = %v", err)
+			}
+
+			status := tt.status
+			if status == "" {
+				status = entities.AttemptGraded
+			}
+			if got.Score != tt.score || got.Status != status {
+				t.Errorf("grade() = %d %s, want %d %s", got.Score, got.Status, tt.score, status)
+			}
+			if got.MaxScore != tt.task.MaxScore {
+				t.Errorf("grade() max score = %d, want %d", got.MaxScore, tt.task.MaxScore)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/lmspackage"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
+)
+
+// ImportPackage creates a new draft course owned by the caller from a
+// zipped Common Cartridge or SCORM 1.2 package. What the package does not
+// tell is taken from defaults. The returned package holds the created
+// course and the items that were skipped.
+func (s *CourseService) ImportPackage(ctx context.Context, data []byte, defaults lmspackage.Defaults) (*lmspackage.Package, error) {
+	const op = "Course.ImportPackage"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("size", len(data)),
+	)
+
+	pkg, err := lmspackage.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	obj := pkg.Course
+	if obj.Description == "" {
+		obj.Description = defaults.Description
+	}
+	obj.Difficulty = defaults.Difficulty
+	obj.Image = defaults.Image
+	for _, theme := range obj.Themes {
+		for _, lesson := range theme.Lessons {
+			lesson.Duration = defaults.LessonDuration
+		}
+	}
+
+	if err := validation.Course(obj); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := renderThemes(obj.Themes); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	obj.Status = entities.StatusDraft
+	obj.OwnerID = auth.UserID(ctx)
+
+	log.Info("trying to import package", slog.String("kind", string(pkg.Kind)))
+	err = s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		return createCourse(ctx, repo, obj)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, obj.ID)
+	log.Info("package successfully imported", slog.Int("id", obj.ID), slog.Int("skipped", len(pkg.Skipped)))
+
+	return pkg, nil
+}
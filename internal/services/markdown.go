@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/markdown"
+)
+
+// renderLesson renders the markdown of a text lesson to sanitized HTML and
+// a table of contents, which are stored next to the source. Other kinds
+// are left alone.
+func renderLesson(obj *entities.Lesson) error {
+	if obj.Kind != entities.LessonText || obj.Text == nil {
+		return nil
+	}
+
+	doc, err := markdown.Render(obj.Text.Markdown)
+	if err != nil {
+		return err
+	}
+
+	obj.Text.HTML = doc.HTML
+	obj.Text.TOC = make([]entities.Heading, len(doc.TOC))
+	for i, h := range doc.TOC {
+		obj.Text.TOC[i] = entities.Heading{Level: h.Level, Text: h.Text, ID: h.ID}
+	}
+
+	return nil
+}
+
+func renderThemes(themes []*entities.Theme) error {
+	for _, theme := range themes {
+		for _, lesson := range theme.Lessons {
+			if err := renderLesson(lesson); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renderBatchSize is how many lessons RenderLegacyLessons renders at a time.
+const renderBatchSize = 100
+
+// RenderLegacyLessons renders and stores the HTML and table of contents of
+// text lessons saved before markdown was rendered on write. Lessons are
+// only rendered once, so running it again finds nothing to do.
+func (s *CourseService) RenderLegacyLessons(ctx context.Context) (int, error) {
+	const op = "Course.RenderLegacyLessons"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("trying to render legacy lessons")
+	rendered := 0
+	courses := make(map[int]bool)
+	for after := 0; ; {
+		lessons, err := s.crsRepo.GetUnrenderedLessons(ctx, after, renderBatchSize)
+		if err != nil {
+			log.Error(err.Error())
+			return rendered, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, lesson := range lessons {
+			if err := renderLesson(lesson); err != nil {
+				log.Error(err.Error(), slog.Int("lid", lesson.ID))
+				return rendered, fmt.Errorf("%s: %w", op, err)
+			}
+			if err := s.crsRepo.SetLessonBody(ctx, lesson); err != nil {
+				log.Error(err.Error(), slog.Int("lid", lesson.ID))
+				return rendered, fmt.Errorf("%s: %w", op, err)
+			}
+			courses[lesson.CourseID] = true
+			rendered++
+		}
+
+		if len(lessons) < renderBatchSize {
+			break
+		}
+		after = lessons[len(lessons)-1].ID
+	}
+
+	for cid := range courses {
+		s.invalidate(ctx, cid)
+	}
+	log.Info("legacy lessons successfully rendered", slog.Int("rendered", rendered))
+
+	return rendered, nil
+}
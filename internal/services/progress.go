@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+// MarkLessonStarted records that the caller opened a lesson of a course
+// they are enrolled in.
+func (s *EnrollmentService) MarkLessonStarted(ctx context.Context, lid int) (*entities.LessonProgress, error) {
+	const op = "Enrollment.MarkLessonStarted"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", lid),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to start lesson")
+	lesson, err := s.crsRepo.GetLesson(ctx, lid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	progress := &entities.LessonProgress{UserID: uid, LessonID: lid, CourseID: lesson.CourseID}
+	err = s.enrRepo.WithTx(ctx, func(repo EnrollmentRepo) error {
+		if err := checkEnrolled(ctx, repo, lesson.CourseID, uid); err != nil {
+			return err
+		}
+		return repo.StartLesson(ctx, progress)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("lesson successfully started")
+
+	return progress, nil
+}
+
+// MarkLessonCompleted records that the caller finished a lesson and returns
+// the updated course progress. Completing the last lesson of the course
+// completes the enrollment.
+func (s *EnrollmentService) MarkLessonCompleted(ctx context.Context, lid int) (*entities.CourseProgress, error) {
+	const op = "Enrollment.MarkLessonCompleted"
+
+	uid := auth.UserID(ctx)
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", lid),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to complete lesson")
+	lesson, err := s.crsRepo.GetLesson(ctx, lid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var progress *entities.CourseProgress
+	err = s.enrRepo.WithTx(ctx, func(repo EnrollmentRepo) error {
+		enrollment, err := repo.GetEnrollment(ctx, lesson.CourseID, uid)
+		if err != nil {
+			return err
+		}
+		if enrollment.Status == entities.EnrollmentDropped {
+			return ErrEnrollmentNotActive.WithMetadata("status", string(enrollment.Status))
+		}
+
+		err = repo.CompleteLesson(ctx, &entities.LessonProgress{UserID: uid, LessonID: lid, CourseID: lesson.CourseID})
+		if err != nil {
+			return err
+		}
+
+		themes, err := repo.GetThemeProgress(ctx, lesson.CourseID, uid)
+		if err != nil {
+			return err
+		}
+		progress = summarizeProgress(lesson.CourseID, uid, themes)
+
+		if enrollment.Status == entities.EnrollmentActive && progress.CompletedLessons == progress.TotalLessons {
+			enrollment.Status = entities.EnrollmentCompleted
+			return repo.SetEnrollmentStatus(ctx, enrollment)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("lesson successfully completed", slog.Float64("percent", float64(progress.Percent)))
+
+	return progress, nil
+}
+
+// GetCourseProgress returns the progress of a student in a course. uid 0
+// means the caller; the progress of other students is only visible to the
+// co-authors of the course and admins.
+func (s *EnrollmentService) GetCourseProgress(ctx context.Context, cid, uid int) (*entities.CourseProgress, error) {
+	const op = "Enrollment.GetCourseProgress"
+
+	caller := auth.UserID(ctx)
+	if uid == 0 {
+		uid = caller
+	}
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+		slog.Int("uid", uid),
+	)
+
+	if uid == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	log.Info("trying to get course progress")
+	if uid != caller {
+		role, err := authorRole(ctx, s.crsRepo, cid)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if role == "" {
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	if _, err := s.enrRepo.GetEnrollment(ctx, cid, uid); err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	themes, err := s.enrRepo.GetThemeProgress(ctx, cid, uid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("course progress successfully geted")
+
+	return summarizeProgress(cid, uid, themes), nil
+}
+
+// checkEnrolled fails unless the user is enrolled in the course and has
+// not dropped it.
+func checkEnrolled(ctx context.Context, repo EnrollmentRepo, cid, uid int) error {
+	enrollment, err := repo.GetEnrollment(ctx, cid, uid)
+	if err != nil {
+		return err
+	}
+
+	if enrollment.Status == entities.EnrollmentDropped {
+		return ErrEnrollmentNotActive.WithMetadata("status", string(enrollment.Status))
+	}
+	return nil
+}
+
+// summarizeProgress adds up the theme progress and fills in the
+// percentages.
+func summarizeProgress(cid, uid int, themes []*entities.ThemeProgress) *entities.CourseProgress {
+	res := &entities.CourseProgress{CourseID: cid, UserID: uid, Themes: themes}
+	for _, th := range themes {
+		th.Percent = percent(th.CompletedDuration, th.TotalDuration, th.CompletedLessons, th.TotalLessons)
+
+		res.CompletedLessons += th.CompletedLessons
+		res.TotalLessons += th.TotalLessons
+		res.CompletedDuration += th.CompletedDuration
+		res.TotalDuration += th.TotalDuration
+	}
+	res.Percent = percent(res.CompletedDuration, res.TotalDuration, res.CompletedLessons, res.TotalLessons)
+
+	return res
+}
+
+// percent weights progress by duration. Lessons without a duration would
+// make that meaningless, so it falls back to counting lessons when the total
+// duration is zero.
+func percent(doneDuration, totalDuration, doneLessons, totalLessons int) float32 {
+	switch {
+	case totalDuration > 0:
+		return float32(doneDuration) * 100 / float32(totalDuration)
+	case totalLessons > 0:
+		return float32(doneLessons) * 100 / float32(totalLessons)
+	}
+	return 0
+}
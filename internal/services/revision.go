@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/pkg/textdiff"
+)
+
+var (
+	ErrRevisionNotFound = entities.NewError(entities.KindNotFound, "REVISION_NOT_FOUND", "revision not found")
+	ErrRevisionConflict = entities.NewError(entities.KindFailedPrecondition, "REVISION_CONFLICT", "entity was changed concurrently, retry the request")
+)
+
+// diffContext is the number of unchanged lines kept around each change in
+// a unified diff.
+const diffContext = 3
+
+// recordRevision snapshots the current state of a course or lesson as its
+// next revision. It must run in the transaction that made the change, so
+// the snapshot matches what was written.
+func recordRevision(ctx context.Context, repo CourseRepo, entity entities.RevisionEntity, id int) (*entities.Revision, error) {
+	rev := &entities.Revision{
+		Entity:   entity,
+		EntityID: id,
+		AuthorID: auth.UserID(ctx),
+	}
+
+	switch entity {
+	case entities.RevisionCourse:
+		course, err := repo.GetCourse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rev.CourseID = course.ID
+		rev.Fields = entities.CourseSnapshot(course)
+	case entities.RevisionLesson:
+		lesson, err := repo.GetLesson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rev.CourseID = lesson.CourseID
+		rev.Fields = entities.LessonSnapshot(lesson)
+	default:
+		return nil, ErrRevisionNotFound
+	}
+
+	if err := repo.CreateRevision(ctx, rev); err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+// ListRevisions returns the revisions of a course or lesson, newest first.
+func (s *CourseService) ListRevisions(ctx context.Context, entity entities.RevisionEntity, id int) ([]*entities.Revision, error) {
+	const op = "Course.ListRevisions"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("entity", string(entity)),
+		slog.Int("id", id),
+	)
+
+	if !auth.IsStaff(ctx) {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("trying to get revisions")
+	revisions, err := s.crsRepo.GetRevisions(ctx, entity, id)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(revisions) > 0 {
+		if err := checkAuthor(ctx, s.crsRepo, revisions[0].CourseID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	log.Info("revisions successfully geted")
+
+	return revisions, nil
+}
+
+func (s *CourseService) GetRevision(ctx context.Context, entity entities.RevisionEntity, id, version int) (*entities.Revision, error) {
+	const op = "Course.GetRevision"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("entity", string(entity)),
+		slog.Int("id", id),
+		slog.Int("version", version),
+	)
+
+	if !auth.IsStaff(ctx) {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("trying to get revision")
+	rev, err := s.crsRepo.GetRevision(ctx, entity, id, version)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkAuthor(ctx, s.crsRepo, rev.CourseID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("revision successfully geted")
+
+	return rev, nil
+}
+
+// DiffRevisions returns the fields that differ between two revisions of the
+// same entity, in field name order.
+func (s *CourseService) DiffRevisions(ctx context.Context, entity entities.RevisionEntity, id, from, to int) ([]*entities.FieldDiff, error) {
+	const op = "Course.DiffRevisions"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("entity", string(entity)),
+		slog.Int("id", id),
+		slog.Int("from", from),
+		slog.Int("to", to),
+	)
+
+	if !auth.IsStaff(ctx) {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("trying to diff revisions")
+	oldRev, err := s.crsRepo.GetRevision(ctx, entity, id, from)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkAuthor(ctx, s.crsRepo, oldRev.CourseID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	newRev, err := s.crsRepo.GetRevision(ctx, entity, id, to)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys := slices.Collect(maps.Keys(oldRev.Fields))
+	for k := range newRev.Fields {
+		if _, ok := oldRev.Fields[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	diffs := make([]*entities.FieldDiff, 0, len(keys))
+	for _, k := range keys {
+		oldValue, newValue := oldRev.Fields[k], newRev.Fields[k]
+		if oldValue == newValue {
+			continue
+		}
+		diffs = append(diffs, &entities.FieldDiff{
+			Field:    k,
+			OldValue: oldValue,
+			NewValue: newValue,
+			Unified:  textdiff.Unified(oldValue, newValue, diffContext),
+		})
+	}
+	log.Info("revisions successfully diffed", slog.Int("changes", len(diffs)))
+
+	return diffs, nil
+}
+
+// RevertToRevision writes the fields of an old revision back to the entity.
+// History is never rewritten: the revert itself becomes a new revision,
+// which is returned.
+func (s *CourseService) RevertToRevision(ctx context.Context, entity entities.RevisionEntity, id, version int) (*entities.Revision, error) {
+	const op = "Course.RevertToRevision"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("entity", string(entity)),
+		slog.Int("id", id),
+		slog.Int("version", version),
+	)
+
+	if !auth.IsStaff(ctx) {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	var reverted *entities.Revision
+	log.Info("trying to revert to revision")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		rev, err := repo.GetRevision(ctx, entity, id, version)
+		if err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, rev.CourseID); err != nil {
+			return err
+		}
+
+		switch entity {
+		case entities.RevisionCourse:
+			course, err := repo.GetCourse(ctx, id)
+			if err != nil {
+				return err
+			}
+			entities.ApplyCourseSnapshot(course, rev.Fields)
+			fields := slices.DeleteFunc(slices.Clone(courseMaskFields), func(f string) bool { return f == themesField })
+			_, err = repo.UpdateCourse(ctx, course, fields...)
+			if err != nil {
+				return err
+			}
+		case entities.RevisionLesson:
+			lesson, err := repo.GetLesson(ctx, id)
+			if err != nil {
+				return err
+			}
+			entities.ApplyLessonSnapshot(lesson, rev.Fields)
+			if err := renderLesson(lesson); err != nil {
+				return err
+			}
+			_, err = repo.UpdateLesson(ctx, lesson, lessonMaskFields...)
+			if err != nil {
+				return err
+			}
+		}
+
+		reverted, err = recordRevision(ctx, repo, entity, id)
+		return err
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, reverted.CourseID)
+	log.Info("successfully reverted to revision", slog.Int("new_version", reverted.Version))
+
+	return reverted, nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var (
+	ErrInvalidTransition   = entities.NewError(entities.KindFailedPrecondition, "INVALID_STATUS_TRANSITION", "course cannot move to this status from its current one")
+	ErrCourseStatusChanged = entities.NewError(entities.KindFailedPrecondition, "COURSE_STATUS_CHANGED", "course status was changed concurrently, retry the request")
+)
+
+// visibleStatuses returns the statuses the caller may see in listings of
+// every course. Admins see every course, everybody else only published
+// ones; authors find their own drafts through their course list.
+func visibleStatuses(ctx context.Context) []entities.CourseStatus {
+	if auth.FromContext(ctx).HasRole(auth.RoleAdmin) {
+		return nil
+	}
+	return []entities.CourseStatus{entities.StatusPublished}
+}
+
+// isVisible reports whether the caller may see the course: published
+// courses are seen by everybody, the others only by their authors and
+// admins.
+func isVisible(ctx context.Context, repo CourseRepo, course *entities.Course) (bool, error) {
+	if course.Status == entities.StatusPublished {
+		return true, nil
+	}
+
+	role, err := authorRole(ctx, repo, course.ID)
+	if err != nil {
+		return false, err
+	}
+	return visibleWithRole(course, role), nil
+}
+
+// visibleWithRole is isVisible for a caller whose role in the course is
+// already known.
+func visibleWithRole(course *entities.Course, role entities.AuthorRole) bool {
+	return course.Status == entities.StatusPublished || role != ""
+}
+
+// checkVisible fails with ErrCourseNotFound if the caller may not see the
+// course, so unpublished courses do not leak through their themes or
+// lessons.
+func checkVisible(ctx context.Context, repo CourseRepo, cid int) error {
+	course, err := repo.GetCourse(ctx, cid)
+	if err != nil {
+		return err
+	}
+
+	visible, err := isVisible(ctx, repo, course)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return ErrCourseNotFound
+	}
+	return nil
+}
+
+// ChangeStatus moves a course to the given status if the workflow allows it
+// and records who did it.
+func (s *CourseService) ChangeStatus(ctx context.Context, cid int, to entities.CourseStatus) (*entities.StatusTransition, error) {
+	const op = "Course.ChangeStatus"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+		slog.String("to", string(to)),
+	)
+
+	transition := &entities.StatusTransition{
+		CourseID: cid,
+		To:       to,
+		ActorID:  auth.UserID(ctx),
+	}
+
+	log.Info("trying to change course status")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		course, err := repo.GetCourse(ctx, cid)
+		if err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, cid); err != nil {
+			return err
+		}
+
+		if !course.Status.CanTransitionTo(to) {
+			return ErrInvalidTransition.
+				WithMetadata("from", string(course.Status)).
+				WithMetadata("to", string(to))
+		}
+
+		transition.From = course.Status
+		return repo.SetCourseStatus(ctx, transition)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, cid)
+	log.Info("course status successfully changed", slog.String("from", string(transition.From)))
+
+	return transition, nil
+}
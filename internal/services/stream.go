@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+// StreamCourses is GetAllCourses for catalogs too large to be sent at
+// once: fn is called for every course as it is read.
+func (s *CourseService) StreamCourses(ctx context.Context, fn func(*entities.Course) error) error {
+	const op = "Course.StreamCourses"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("trying to stream courses")
+	err := s.crsRepo.StreamCourses(ctx, fn, visibleStatuses(ctx)...)
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("courses successfully streamed")
+
+	return nil
+}
+
+// StreamCourseContent is GetCourseTree for courses too large to be sent at
+// once: fn is called for the course, every theme and every lesson as they
+// are read. Nothing is sent for courses the caller may not see.
+func (s *CourseService) StreamCourseContent(ctx context.Context, id int, fn func(*entities.CourseContent) error) error {
+	const op = "Course.StreamCourseContent"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("id", id),
+	)
+
+	log.Info("trying to stream course content")
+	role, err := authorRole(ctx, s.crsRepo, id)
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.crsRepo.StreamCourseContent(ctx, id, func(item *entities.CourseContent) error {
+		if item.Course != nil && !visibleWithRole(item.Course, role) {
+			return ErrCourseNotFound
+		}
+		return fn(item)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("course content successfully streamed")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/validation"
+)
+
+var ErrTaskNotFound = entities.NewError(entities.KindNotFound, "TASK_NOT_FOUND", "lesson has no task")
+
+// defaultMaxScore is used for tasks created without a max score. It leaves
+// room for partial credit on multiple choice tasks.
+const defaultMaxScore = 100
+
+// SetTask attaches a typed task to a lesson, replacing the one it had.
+func (s *CourseService) SetTask(ctx context.Context, obj *entities.Task) error {
+	const op = "Course.SetTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", obj.LessonID),
+		slog.String("kind", string(obj.Kind)),
+	)
+
+	if err := validation.Task(obj); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if obj.MaxScore == 0 {
+		obj.MaxScore = defaultMaxScore
+	}
+
+	log.Info("trying to set task")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		lesson, err := repo.GetLesson(ctx, obj.LessonID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkEditor(ctx, repo, lesson.CourseID); err != nil {
+			return err
+		}
+
+		obj.CourseID = lesson.CourseID
+		return repo.SetTask(ctx, obj)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("task successfully set")
+
+	return nil
+}
+
+// GetTask returns the task of a lesson. Solutions are only included for
+// the editors of the course.
+func (s *CourseService) GetTask(ctx context.Context, lid int) (*entities.Task, error) {
+	const op = "Course.GetTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("lid", lid),
+	)
+
+	log.Info("trying to get task")
+	task, err := s.crsRepo.GetTask(ctx, lid)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVisible(ctx, s.crsRepo, task.CourseID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	role, err := authorRole(ctx, s.crsRepo, task.CourseID)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !role.CanEdit() {
+		task = task.HideSolution()
+	}
+	log.Info("task successfully geted")
+
+	return task, nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/auth"
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+func (s *CourseService) RestoreCourse(ctx context.Context, cid int) error {
+	const op = "Course.RestoreCourse"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("cid", cid),
+	)
+
+	log.Info("trying to restore course")
+	err := s.crsRepo.WithTx(ctx, func(repo CourseRepo) error {
+		if err := checkOwner(ctx, repo, cid); err != nil {
+			return err
+		}
+		return repo.RestoreCourse(ctx, cid)
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidate(ctx, cid)
+	log.Info("course successfully restored")
+
+	return nil
+}
+
+// ListDeletedCourses returns the soft-deleted courses that are still
+// restorable. Only admins may call it.
+func (s *CourseService) ListDeletedCourses(ctx context.Context) ([]*entities.Course, error) {
+	const op = "Course.ListDeletedCourses"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if !auth.FromContext(ctx).HasRole(auth.RoleAdmin) {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("trying to get deleted courses")
+	courses, err := s.crsRepo.GetDeletedCourses(ctx)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Info("deleted courses successfully geted")
+
+	return courses, nil
+}
+
+// PurgeDeletedCourses hard-deletes courses that have been soft-deleted for
+// longer than retention.
+func (s *CourseService) PurgeDeletedCourses(ctx context.Context, retention time.Duration) (int64, error) {
+	const op = "Course.PurgeDeletedCourses"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Duration("retention", retention),
+	)
+
+	n, err := s.crsRepo.PurgeCourses(ctx, time.Now().Add(-retention))
+	if err != nil {
+		log.Error(err.Error())
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if n > 0 {
+		log.Info("deleted courses purged", slog.Int64("count", n))
+	}
+
+	return n, nil
+}
@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+var (
+	TextBodySchema = Schema{
+		"markdown": {Required(), MaxLen(MaxMarkdownLen)},
+	}
+
+	VideoBodySchema = Schema{
+		"url":              {Required(), URL()},
+		"duration_seconds": {NonNegative()},
+	}
+
+	AssignmentBodySchema = Schema{
+		"instructions": {Required()},
+		"due_days":     {NonNegative()},
+	}
+
+	LinkBodySchema = Schema{
+		"url":   {Required(), URL()},
+		"label": {MaxLen(maxTitleLen)},
+	}
+)
+
+// checkLessonBody checks the body that matches the kind of the lesson.
+// Violations are reported under the kind, as in the proto oneof. Quizzes
+// have nothing to check here, their questions are the lesson task.
+func checkLessonBody(prefix string, obj *entities.Lesson, out *[]entities.FieldViolation) {
+	path := join(prefix, string(obj.Kind))
+
+	switch {
+	case obj.Text != nil && obj.Kind == entities.LessonText:
+		check(path, TextBodySchema, nil, map[string]any{
+			"markdown": obj.Text.Markdown,
+		}, out)
+	case obj.Video != nil && obj.Kind == entities.LessonVideo:
+		check(path, VideoBodySchema, nil, map[string]any{
+			"url":              obj.Video.URL,
+			"duration_seconds": obj.Video.DurationSeconds,
+		}, out)
+	case obj.Quiz != nil && obj.Kind == entities.LessonQuiz:
+	case obj.Assignment != nil && obj.Kind == entities.LessonAssignment:
+		check(path, AssignmentBodySchema, nil, map[string]any{
+			"instructions": obj.Assignment.Instructions,
+			"due_days":     obj.Assignment.DueDays,
+		}, out)
+	case obj.Link != nil && obj.Kind == entities.LessonLink:
+		check(path, LinkBodySchema, nil, map[string]any{
+			"url":   obj.Link.URL,
+			"label": obj.Link.Label,
+		}, out)
+	default:
+		applyRules(join(prefix, "body"), []Rule{Required()}, nil, out)
+	}
+}
@@ -0,0 +1,142 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Rule checks a single field value and returns a human readable description
+// of the problem, or an empty string if the value is valid.
+type Rule func(v any) string
+
+// Schema maps field names (as spelled in the proto definition) to the rules
+// applied to them.
+type Schema map[string][]Rule
+
+// Merge returns a new schema containing the rules of all given schemas.
+func Merge(schemas ...Schema) Schema {
+	res := make(Schema)
+	for _, s := range schemas {
+		for field, rules := range s {
+			res[field] = append(res[field], rules...)
+		}
+	}
+
+	return res
+}
+
+func Required() Rule {
+	return func(v any) string {
+		switch val := v.(type) {
+		case string:
+			if strings.TrimSpace(val) == "" {
+				return "must not be empty"
+			}
+		case []byte:
+			if len(val) == 0 {
+				return "must not be empty"
+			}
+		case nil:
+			return "is required"
+		default:
+			if n, ok := toInt(v); ok && n == 0 {
+				return "is required"
+			}
+		}
+		return ""
+	}
+}
+
+func MaxLen(n int) Rule {
+	return func(v any) string {
+		s, ok := v.(string)
+		if ok && utf8.RuneCountInString(s) > n {
+			return fmt.Sprintf("must be at most %d characters long", n)
+		}
+		return ""
+	}
+}
+
+func Positive() Rule {
+	return func(v any) string {
+		n, ok := toInt(v)
+		if ok && n <= 0 {
+			return "must be greater than zero"
+		}
+		return ""
+	}
+}
+
+func Max(limit int64) Rule {
+	return func(v any) string {
+		n, ok := toInt(v)
+		if ok && n > limit {
+			return fmt.Sprintf("must be at most %d", limit)
+		}
+		return ""
+	}
+}
+
+func NonNegative() Rule {
+	return func(v any) string {
+		n, ok := toInt(v)
+		if ok && n < 0 {
+			return "must not be negative"
+		}
+		return ""
+	}
+}
+
+func OneOf(values ...string) Rule {
+	return func(v any) string {
+		s, ok := v.(string)
+		if ok && !slices.Contains(values, s) {
+			return "must be one of: " + strings.Join(values, ", ")
+		}
+		return ""
+	}
+}
+
+// URL accepts absolute http and https URLs. Empty strings are left to
+// Required.
+func URL() Rule {
+	return func(v any) string {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return ""
+		}
+		u, err := url.ParseRequestURI(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "must be a valid http(s) URL"
+		}
+		return ""
+	}
+}
+
+func toInt(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case *int32:
+		if n == nil {
+			return 0, false
+		}
+		return int64(*n), true
+	case int64:
+		return n, true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		return int64(n), true
+	case protoreflect.EnumNumber:
+		return int64(n), true
+	}
+	return 0, false
+}
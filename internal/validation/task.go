@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+)
+
+const (
+	minChoiceOptions = 2
+	maxChoiceOptions = 20
+)
+
+var (
+	ChoiceTaskSchema = Schema{
+		"question": {Required()},
+	}
+
+	FreeTextTaskSchema = Schema{
+		"question": {Required()},
+	}
+
+	CodeTaskSchema = Schema{
+		"statement":       {Required()},
+		"language":        {Required(), MaxLen(32)},
+		"expected_output": {Required()},
+	}
+)
+
+// Task validates a lesson task: the body has to match the kind and choice
+// tasks need sensible options and answers.
+func Task(obj *entities.Task) error {
+	var out []entities.FieldViolation
+	checkTask("", obj, &out)
+	return toError(out)
+}
+
+func checkTask(prefix string, obj *entities.Task, out *[]entities.FieldViolation) {
+	applyRules(join(prefix, "max_score"), []Rule{NonNegative()}, obj.MaxScore, out)
+
+	switch obj.Kind {
+	case entities.TaskSingleChoice, entities.TaskMultipleChoice:
+		if obj.Choice == nil {
+			applyRules(join(prefix, string(obj.Kind)), []Rule{Required()}, nil, out)
+			break
+		}
+		checkChoiceTask(join(prefix, string(obj.Kind)), obj.Kind, obj.Choice, out)
+	case entities.TaskFreeText:
+		if obj.FreeText == nil {
+			applyRules(join(prefix, "free_text"), []Rule{Required()}, nil, out)
+			break
+		}
+		check(join(prefix, "free_text"), FreeTextTaskSchema, nil, map[string]any{
+			"question": obj.FreeText.Question,
+		}, out)
+		for i, a := range obj.FreeText.AcceptedAnswers {
+			applyRules(join(prefix, fmt.Sprintf("free_text.accepted_answers[%d]", i)), []Rule{Required()}, a, out)
+		}
+	case entities.TaskCode:
+		if obj.Code == nil {
+			applyRules(join(prefix, "code"), []Rule{Required()}, nil, out)
+			break
+		}
+		check(join(prefix, "code"), CodeTaskSchema, nil, map[string]any{
+			"statement":       obj.Code.Statement,
+			"language":        obj.Code.Language,
+			"expected_output": obj.Code.ExpectedOutput,
+		}, out)
+	default:
+		applyRules(join(prefix, "kind"), []Rule{Required()}, nil, out)
+	}
+}
+
+func checkChoiceTask(prefix string, kind entities.TaskKind, obj *entities.ChoiceTask, out *[]entities.FieldViolation) {
+	check(prefix, ChoiceTaskSchema, nil, map[string]any{
+		"question": obj.Question,
+	}, out)
+
+	if n := len(obj.Options); n < minChoiceOptions || n > maxChoiceOptions {
+		*out = append(*out, entities.FieldViolation{
+			Field:       join(prefix, "options"),
+			Description: fmt.Sprintf("must have between %d and %d options", minChoiceOptions, maxChoiceOptions),
+		})
+	}
+	for i, opt := range obj.Options {
+		applyRules(join(prefix, fmt.Sprintf("options[%d]", i)), []Rule{Required()}, opt, out)
+	}
+
+	switch {
+	case kind == entities.TaskSingleChoice && len(obj.Correct) != 1:
+		*out = append(*out, entities.FieldViolation{Field: join(prefix, "correct"), Description: "must name exactly one option"})
+	case len(obj.Correct) == 0:
+		*out = append(*out, entities.FieldViolation{Field: join(prefix, "correct"), Description: "must name at least one option"})
+	}
+
+	seen := make(map[int]bool, len(obj.Correct))
+	for i, c := range obj.Correct {
+		path := join(prefix, fmt.Sprintf("correct[%d]", i))
+		switch {
+		case c < 0 || c >= len(obj.Options):
+			*out = append(*out, entities.FieldViolation{Field: path, Description: "must be the index of an option"})
+		case seen[c]:
+			*out = append(*out, entities.FieldViolation{Field: path, Description: "must not repeat an option"})
+		}
+		seen[c] = true
+	}
+}
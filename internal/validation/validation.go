@@ -0,0 +1,385 @@
+// Package validation holds the declarative input rules of the course
+// service. The same schemas are applied to incoming gRPC messages by an
+// interceptor and to entities by the service layer.
+package validation
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/Homyakadze14/CourseMicroserviceForOrbitOfSuccess/internal/entities"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var ErrInvalidRequest = entities.NewError(entities.KindInvalidArgument, "VALIDATION_FAILED", "request validation failed")
+
+// MaxMarkdownLen is the longest lesson text accepted, in characters.
+const MaxMarkdownLen = 100_000
+
+const (
+	maxTitleLen     = 255
+	updateMaskField = "update_mask"
+)
+
+var maskName = (&fieldmaskpb.FieldMask{}).ProtoReflect().Descriptor().FullName()
+
+var (
+	idSchema = Schema{
+		"id": {Required(), Positive()},
+	}
+
+	optionalIDSchema = Schema{
+		"id": {Positive()},
+	}
+
+	revisionSchema = Schema{
+		"entity":  {Required()},
+		"id":      {Required(), Positive()},
+		"version": {Required(), Positive()},
+	}
+
+	CourseSchema = Schema{
+		"title":             {Required(), MaxLen(maxTitleLen)},
+		"description":       {Required()},
+		"difficulty":        {Required(), OneOf(entities.Difficulties...)},
+		"duration_override": {Positive()},
+		"image":             {Required(), URL()},
+	}
+
+	ThemeSchema = Schema{
+		"title":             {Required(), MaxLen(maxTitleLen)},
+		"duration_override": {Positive()},
+	}
+
+	LessonSchema = Schema{
+		"title":    {Required(), MaxLen(maxTitleLen)},
+		"duration": {Positive()},
+	}
+)
+
+// messages binds proto message full names to their schema. Nested messages
+// are looked up here as well, so CreateRequest.themes is checked with the
+// CreateTheme schema.
+var messages = map[protoreflect.FullName]Schema{
+	"CreateRequest":        CourseSchema,
+	"CreateTheme":          ThemeSchema,
+	"CreateLesson":         LessonSchema,
+	"GetCourseRequest":     idSchema,
+	"DeleteCourseRequest":  idSchema,
+	"RestoreCourseRequest": idSchema,
+	"UpdateCourseRequest":  Merge(CourseSchema, idSchema),
+	"UpdateTheme":          Merge(ThemeSchema, optionalIDSchema),
+	"UpdateLesson":         Merge(LessonSchema, optionalIDSchema),
+	"CreateThemeRequest": {
+		"course_id": {Required(), Positive()},
+		"theme":     {Required()},
+	},
+	"GetThemeRequest":    idSchema,
+	"UpdateThemeRequest": Merge(ThemeSchema, idSchema),
+	"DeleteThemeRequest": idSchema,
+	"CreateLessonRequest": {
+		"theme_id": {Required(), Positive()},
+		"lesson":   {Required()},
+	},
+	"GetLessonRequest":          idSchema,
+	"UpdateLessonRequest":       Merge(LessonSchema, idSchema),
+	"DeleteLessonRequest":       idSchema,
+	"ChangeCourseStatusRequest": idSchema,
+	"ListCoursesRequest": {
+		"page_size":    {NonNegative(), Max(100)},
+		"difficulties": {OneOf(entities.Difficulties...)},
+		"min_duration": {NonNegative()},
+		"max_duration": {NonNegative()},
+	},
+	"ExportCourseRequest": idSchema,
+	"CourseArchive": {
+		"data": {Required()},
+	},
+	"PackageInfo": {
+		"difficulty":      {Required(), OneOf(entities.Difficulties...)},
+		"image":           {Required(), URL()},
+		"lesson_duration": {Required(), Positive()},
+	},
+	"ReorderRequest": {
+		"course_id": {Required(), Positive()},
+		"theme_ids": {Positive()},
+	},
+	"LessonOrder": {
+		"theme_id":   {Required(), Positive()},
+		"lesson_ids": {Positive()},
+	},
+	"AddCoAuthorRequest": {
+		"course_id": {Required(), Positive()},
+		"user_id":   {Required(), Positive()},
+		"role":      {Required()},
+	},
+	"RemoveCoAuthorRequest": {
+		"course_id": {Required(), Positive()},
+		"user_id":   {Required(), Positive()},
+	},
+	"ListCoAuthorsRequest": {
+		"course_id": {Required(), Positive()},
+	},
+	"EnrollRequest": {
+		"course_id": {Required(), Positive()},
+	},
+	"UnenrollRequest": {
+		"course_id": {Required(), Positive()},
+	},
+	"TextBody":       TextBodySchema,
+	"VideoBody":      VideoBodySchema,
+	"AssignmentBody": AssignmentBodySchema,
+	"LinkBody":       LinkBodySchema,
+	"TaskSpec": {
+		"lesson_id": {Required(), Positive()},
+		"max_score": {NonNegative()},
+	},
+	"ChoiceTask": {
+		"question": {Required()},
+		"options":  {Required()},
+		"correct":  {NonNegative()},
+	},
+	"FreeTextTask": FreeTextTaskSchema,
+	"CodeTask":     CodeTaskSchema,
+	"GetLessonTaskRequest": {
+		"lesson_id": {Required(), Positive()},
+	},
+	"SubmitTaskAnswerRequest": {
+		"lesson_id": {Required(), Positive()},
+		"answer":    {Required()},
+	},
+	"TaskAnswer": {
+		"choices": {NonNegative()},
+	},
+	"ListAttemptsRequest": {
+		"lesson_id": {Required(), Positive()},
+		"user_id":   {NonNegative()},
+	},
+	"ReviewAttemptRequest": {
+		"attempt_id": {Required(), Positive()},
+		"score":      {NonNegative()},
+	},
+	"MarkLessonRequest": {
+		"lesson_id": {Required(), Positive()},
+	},
+	"GetCourseProgressRequest": {
+		"course_id": {Required(), Positive()},
+		"user_id":   {NonNegative()},
+	},
+	"ListCourseStudentsRequest": {
+		"course_id": {Required(), Positive()},
+	},
+	"ListRevisionsRequest": {
+		"entity": {Required()},
+		"id":     {Required(), Positive()},
+	},
+	"GetRevisionRequest":      revisionSchema,
+	"RevertToRevisionRequest": revisionSchema,
+	"DiffRevisionsRequest": {
+		"entity":       {Required()},
+		"id":           {Required(), Positive()},
+		"from_version": {Required(), Positive()},
+		"to_version":   {Required(), Positive()},
+	},
+	"SearchCoursesRequest": {
+		"query":  {Required(), MaxLen(256)},
+		"limit":  {NonNegative(), Max(100)},
+		"offset": {NonNegative()},
+	},
+}
+
+// Message validates a proto message and all of its nested messages.
+func Message(m proto.Message) error {
+	var out []entities.FieldViolation
+	checkMessage("", m.ProtoReflect(), &out)
+	return toError(out)
+}
+
+// Course validates a course together with its themes and lessons. When
+// fields are given only those are checked, as for a partial update.
+func Course(obj *entities.Course, fields ...string) error {
+	var out []entities.FieldViolation
+	checkCourse(obj, fieldSet(fields), &out)
+	return toError(out)
+}
+
+// Theme validates a theme together with its lessons.
+func Theme(obj *entities.Theme, fields ...string) error {
+	var out []entities.FieldViolation
+	checkTheme("", obj, fieldSet(fields), &out)
+	return toError(out)
+}
+
+func Lesson(obj *entities.Lesson, fields ...string) error {
+	var out []entities.FieldViolation
+	checkLesson("", obj, fieldSet(fields), &out)
+	return toError(out)
+}
+
+// fieldSet returns nil, meaning "every field", for an empty list.
+func fieldSet(fields []string) map[string]bool {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f] = true
+	}
+	return set
+}
+
+func checkCourse(obj *entities.Course, only map[string]bool, out *[]entities.FieldViolation) {
+	check("", CourseSchema, only, map[string]any{
+		"title":             obj.Title,
+		"description":       obj.Description,
+		"full_description":  obj.FullDescription,
+		"work":              obj.Work,
+		"difficulty":        obj.Difficulty,
+		"duration_override": obj.DurationOverride,
+		"image":             obj.Image,
+	}, out)
+
+	if only != nil && !only["themes"] {
+		return
+	}
+	for i, theme := range obj.Themes {
+		checkTheme(fmt.Sprintf("themes[%d]", i), theme, nil, out)
+	}
+}
+
+func checkTheme(prefix string, obj *entities.Theme, only map[string]bool, out *[]entities.FieldViolation) {
+	check(prefix, ThemeSchema, only, map[string]any{
+		"title":             obj.Title,
+		"duration_override": obj.DurationOverride,
+	}, out)
+
+	for i, lesson := range obj.Lessons {
+		checkLesson(join(prefix, fmt.Sprintf("lessons[%d]", i)), lesson, nil, out)
+	}
+}
+
+func checkLesson(prefix string, obj *entities.Lesson, only map[string]bool, out *[]entities.FieldViolation) {
+	check(prefix, LessonSchema, only, map[string]any{
+		"title":    obj.Title,
+		"duration": obj.Duration,
+		"task":     obj.Task,
+	}, out)
+
+	if only == nil || only["body"] {
+		checkLessonBody(prefix, obj, out)
+	}
+	if obj.TaskSpec != nil {
+		checkTask(join(prefix, "task_spec"), obj.TaskSpec, out)
+	}
+}
+
+func check(prefix string, schema Schema, only map[string]bool, values map[string]any, out *[]entities.FieldViolation) {
+	for _, field := range slices.Sorted(maps.Keys(schema)) {
+		if only != nil && !only[field] {
+			continue
+		}
+		applyRules(join(prefix, field), schema[field], values[field], out)
+	}
+}
+
+func checkMessage(prefix string, m protoreflect.Message, out *[]entities.FieldViolation) {
+	schema := messages[m.Descriptor().FullName()]
+	fields := m.Descriptor().Fields()
+	only := maskedFields(m)
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := join(prefix, string(fd.Name()))
+
+		if only != nil && !only[string(fd.Name())] {
+			continue
+		}
+
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				checkMessage(fmt.Sprintf("%s[%d]", path, j), list.Get(j).Message(), out)
+			}
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				applyRules(fmt.Sprintf("%s[%d]", path, j), schema[string(fd.Name())], list.Get(j).Interface(), out)
+			}
+		case fd.IsMap():
+		case fd.Kind() == protoreflect.MessageKind:
+			if !m.Has(fd) {
+				applyRules(path, schema[string(fd.Name())], nil, out)
+				continue
+			}
+			checkMessage(path, m.Get(fd).Message(), out)
+		default:
+			var value any
+			if !fd.HasPresence() || m.Has(fd) {
+				value = m.Get(fd).Interface()
+			}
+			applyRules(path, schema[string(fd.Name())], value, out)
+		}
+	}
+}
+
+// maskedFields returns the fields named by a non-empty update_mask of m,
+// together with the id and the mask itself, or nil if m is not a partial
+// update. Naming a oneof selects all of its fields.
+func maskedFields(m protoreflect.Message) map[string]bool {
+	fd := m.Descriptor().Fields().ByName(updateMaskField)
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != maskName || !m.Has(fd) {
+		return nil
+	}
+
+	mask, ok := m.Get(fd).Message().Interface().(*fieldmaskpb.FieldMask)
+	if !ok || len(mask.GetPaths()) == 0 {
+		return nil
+	}
+
+	only := fieldSet(mask.GetPaths())
+	oneofs := m.Descriptor().Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		if od := oneofs.Get(i); only[string(od.Name())] {
+			for j := 0; j < od.Fields().Len(); j++ {
+				only[string(od.Fields().Get(j).Name())] = true
+			}
+		}
+	}
+	only["id"] = true
+	only[updateMaskField] = true
+	return only
+}
+
+func applyRules(path string, rules []Rule, value any, out *[]entities.FieldViolation) {
+	for _, rule := range rules {
+		if desc := rule(value); desc != "" {
+			*out = append(*out, entities.FieldViolation{Field: path, Description: desc})
+			return
+		}
+	}
+}
+
+func toError(violations []entities.FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	err := ErrInvalidRequest
+	for _, v := range violations {
+		err = err.WithField(v.Field, v.Description)
+	}
+
+	return err
+}
+
+func join(prefix, field string) string {
+	if prefix == "" {
+		return field
+	}
+	return prefix + "." + field
+}
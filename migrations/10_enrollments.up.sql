@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS enrollment(
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed', 'dropped')),
+    enrolled_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (course_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS enrollment_user_idx ON enrollment(user_id);
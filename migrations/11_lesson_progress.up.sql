@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS lesson_progress(
+    user_id INT NOT NULL,
+    lesson_id INT NOT NULL REFERENCES lesson(id) ON DELETE CASCADE,
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at TIMESTAMPTZ,
+    PRIMARY KEY (user_id, lesson_id)
+);
+
+CREATE INDEX IF NOT EXISTS lesson_progress_course_idx ON lesson_progress(course_id, user_id);
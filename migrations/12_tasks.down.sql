@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_attempt;
+DROP TABLE IF EXISTS lesson_task;
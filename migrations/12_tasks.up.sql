@@ -0,0 +1,22 @@
+-- spec holds the body of the task kind as JSON, see entities.Task.
+CREATE TABLE IF NOT EXISTS lesson_task(
+    lesson_id INT PRIMARY KEY REFERENCES lesson(id) ON DELETE CASCADE,
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('single_choice', 'multiple_choice', 'free_text', 'code')),
+    max_score INT NOT NULL CHECK (max_score > 0),
+    spec JSONB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS task_attempt(
+    id INT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    lesson_id INT NOT NULL REFERENCES lesson(id) ON DELETE CASCADE,
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    answer JSONB NOT NULL,
+    score INT NOT NULL,
+    max_score INT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('graded', 'pending_review')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS task_attempt_lesson_user_idx ON task_attempt(lesson_id, user_id, created_at);
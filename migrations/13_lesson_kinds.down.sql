@@ -0,0 +1,20 @@
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS type TEXT;
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS content TEXT;
+
+UPDATE lesson SET type = kind, content = coalesce(body->>'markdown', body->>'instructions', body->>'url', '');
+
+ALTER TABLE lesson
+    ALTER COLUMN type SET NOT NULL,
+    ALTER COLUMN content SET NOT NULL;
+
+DROP INDEX IF EXISTS lesson_search_idx;
+ALTER TABLE lesson DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE lesson DROP CONSTRAINT IF EXISTS lesson_kind_check;
+ALTER TABLE lesson DROP COLUMN IF EXISTS kind, DROP COLUMN IF EXISTS body;
+
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(content, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS lesson_search_idx ON lesson USING GIN(search_vector);
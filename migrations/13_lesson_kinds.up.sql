@@ -0,0 +1,38 @@
+-- body holds the body of the lesson kind as JSON, see entities.Lesson.
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS kind TEXT;
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS body JSONB;
+
+-- Old lessons only have a free-form type and one content string. Videos and
+-- links kept their URL in content, everything else becomes markdown text.
+UPDATE lesson SET kind = CASE
+    WHEN lower(type) = 'video' AND content ~* '^https?://' THEN 'video'
+    WHEN lower(type) IN ('link', 'url', 'external') AND content ~* '^https?://' THEN 'link'
+    WHEN lower(type) IN ('quiz', 'test') THEN 'quiz'
+    WHEN lower(type) IN ('assignment', 'homework', 'practice') THEN 'assignment'
+    ELSE 'text'
+END;
+
+UPDATE lesson SET body = CASE kind
+    WHEN 'video' THEN jsonb_build_object('url', trim(content))
+    WHEN 'link' THEN jsonb_build_object('url', trim(content))
+    WHEN 'quiz' THEN jsonb_build_object('instructions', content)
+    WHEN 'assignment' THEN jsonb_build_object('instructions', content)
+    ELSE jsonb_build_object('markdown', content)
+END;
+
+ALTER TABLE lesson
+    ALTER COLUMN kind SET NOT NULL,
+    ALTER COLUMN body SET NOT NULL,
+    ADD CONSTRAINT lesson_kind_check CHECK (kind IN ('text', 'video', 'quiz', 'assignment', 'link'));
+
+-- The search vector is generated from content, so it has to go first.
+DROP INDEX IF EXISTS lesson_search_idx;
+ALTER TABLE lesson DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE lesson DROP COLUMN IF EXISTS type, DROP COLUMN IF EXISTS content;
+
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(body->>'markdown', body->>'instructions', body->>'label', '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS lesson_search_idx ON lesson USING GIN(search_vector);
@@ -0,0 +1,6 @@
+ALTER TABLE theme DROP COLUMN IF EXISTS duration_override;
+ALTER TABLE theme DROP COLUMN IF EXISTS computed_duration;
+ALTER TABLE theme DROP COLUMN IF EXISTS duration;
+
+ALTER TABLE course DROP COLUMN IF EXISTS duration_override;
+ALTER TABLE course DROP COLUMN IF EXISTS computed_duration;
@@ -0,0 +1,23 @@
+-- duration is what students see: duration_override if an author set one,
+-- computed_duration otherwise. The repository keeps both up to date.
+ALTER TABLE course ADD COLUMN IF NOT EXISTS computed_duration INT NOT NULL DEFAULT 0;
+ALTER TABLE course ADD COLUMN IF NOT EXISTS duration_override INT CHECK (duration_override > 0);
+
+ALTER TABLE theme ADD COLUMN IF NOT EXISTS duration INT NOT NULL DEFAULT 0;
+ALTER TABLE theme ADD COLUMN IF NOT EXISTS computed_duration INT NOT NULL DEFAULT 0;
+ALTER TABLE theme ADD COLUMN IF NOT EXISTS duration_override INT CHECK (duration_override > 0);
+
+UPDATE theme t SET computed_duration = s.total, duration = s.total
+FROM (
+    SELECT t.id, COALESCE(sum(l.duration), 0) AS total
+    FROM theme t LEFT JOIN lesson l ON l.theme_id = t.id
+    GROUP BY t.id
+) s
+WHERE t.id = s.id;
+
+UPDATE course c SET computed_duration = COALESCE((SELECT sum(l.duration) FROM lesson l WHERE l.course_id = c.id), 0);
+
+-- Courses without lessons have nothing to compute from, so their hand
+-- entered duration becomes an override.
+UPDATE course SET duration_override = duration WHERE computed_duration = 0 AND duration > 0;
+UPDATE course SET duration = COALESCE(duration_override, computed_duration);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS lesson_external_key_idx;
+DROP INDEX IF EXISTS theme_external_key_idx;
+DROP INDEX IF EXISTS course_external_key_idx;
+
+ALTER TABLE lesson DROP COLUMN IF EXISTS external_key;
+ALTER TABLE theme DROP COLUMN IF EXISTS external_key;
+ALTER TABLE course DROP COLUMN IF EXISTS external_key;
@@ -0,0 +1,11 @@
+-- external_key identifies a course, theme or lesson across environments.
+-- Course archives carry the keys, so importing an archive again updates
+-- what the previous import created instead of duplicating it.
+ALTER TABLE course ADD COLUMN IF NOT EXISTS external_key TEXT NOT NULL DEFAULT gen_random_uuid()::text;
+ALTER TABLE theme ADD COLUMN IF NOT EXISTS external_key TEXT NOT NULL DEFAULT gen_random_uuid()::text;
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS external_key TEXT NOT NULL DEFAULT gen_random_uuid()::text;
+
+-- A soft-deleted course does not block importing its archive again.
+CREATE UNIQUE INDEX IF NOT EXISTS course_external_key_idx ON course(external_key) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS theme_external_key_idx ON theme(course_id, external_key);
+CREATE UNIQUE INDEX IF NOT EXISTS lesson_external_key_idx ON lesson(course_id, external_key);
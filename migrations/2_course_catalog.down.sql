@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS course_difficulty_idx;
+DROP INDEX IF EXISTS course_created_at_id_idx;
+DROP INDEX IF EXISTS course_duration_id_idx;
+DROP INDEX IF EXISTS course_title_id_idx;
+
+ALTER TABLE course DROP COLUMN IF EXISTS created_at;
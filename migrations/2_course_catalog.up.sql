@@ -0,0 +1,6 @@
+ALTER TABLE course ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS course_title_id_idx ON course(title, id);
+CREATE INDEX IF NOT EXISTS course_duration_id_idx ON course(duration, id);
+CREATE INDEX IF NOT EXISTS course_created_at_id_idx ON course(created_at, id);
+CREATE INDEX IF NOT EXISTS course_difficulty_idx ON course(difficulty);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS lesson_search_idx;
+DROP INDEX IF EXISTS theme_search_idx;
+DROP INDEX IF EXISTS course_search_idx;
+
+ALTER TABLE lesson DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE theme DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE course DROP COLUMN IF EXISTS search_vector;
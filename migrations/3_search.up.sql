@@ -0,0 +1,20 @@
+-- The russian configuration stems cyrillic words and falls back to the
+-- english stemmer for latin ones, which matches the mixed course content.
+ALTER TABLE course ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('russian', coalesce(full_descritpion, '')), 'C')
+) STORED;
+
+ALTER TABLE theme ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A')
+) STORED;
+
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(content, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS course_search_idx ON course USING GIN(search_vector);
+CREATE INDEX IF NOT EXISTS theme_search_idx ON theme USING GIN(search_vector);
+CREATE INDEX IF NOT EXISTS lesson_search_idx ON lesson USING GIN(search_vector);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS lesson_theme_position_idx;
+DROP INDEX IF EXISTS theme_course_position_idx;
+
+ALTER TABLE lesson DROP COLUMN IF EXISTS position;
+ALTER TABLE theme DROP COLUMN IF EXISTS position;
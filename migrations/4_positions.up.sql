@@ -0,0 +1,14 @@
+ALTER TABLE theme ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE lesson ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+
+-- Keep the order existing rows were inserted in.
+UPDATE theme t SET position = s.pos
+FROM (SELECT id, row_number() OVER (PARTITION BY course_id ORDER BY id) - 1 AS pos FROM theme) s
+WHERE t.id = s.id;
+
+UPDATE lesson l SET position = s.pos
+FROM (SELECT id, row_number() OVER (PARTITION BY theme_id ORDER BY id) - 1 AS pos FROM lesson) s
+WHERE l.id = s.id;
+
+CREATE INDEX IF NOT EXISTS theme_course_position_idx ON theme(course_id, position, id);
+CREATE INDEX IF NOT EXISTS lesson_theme_position_idx ON lesson(theme_id, position, id);
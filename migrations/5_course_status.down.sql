@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS course_status_transition;
+
+DROP INDEX IF EXISTS course_status_idx;
+ALTER TABLE course DROP COLUMN IF EXISTS status;
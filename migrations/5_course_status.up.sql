@@ -0,0 +1,18 @@
+ALTER TABLE course ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'draft'
+    CHECK (status IN ('draft', 'review', 'published', 'archived'));
+
+-- Courses created before the workflow existed were already public.
+UPDATE course SET status = 'published';
+
+CREATE INDEX IF NOT EXISTS course_status_idx ON course(status);
+
+CREATE TABLE IF NOT EXISTS course_status_transition(
+    id INT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    actor_id INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS course_status_transition_course_idx ON course_status_transition(course_id, created_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS course_deleted_at_idx;
+
+DELETE FROM course WHERE deleted_at IS NOT NULL;
+ALTER TABLE course DROP COLUMN IF EXISTS deleted_at;
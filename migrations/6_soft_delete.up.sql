@@ -0,0 +1,3 @@
+ALTER TABLE course ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS course_deleted_at_idx ON course(deleted_at) WHERE deleted_at IS NOT NULL;
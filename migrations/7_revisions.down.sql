@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS revision_no_update ON revision;
+DROP FUNCTION IF EXISTS revision_immutable();
+DROP TABLE IF EXISTS revision;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS revision(
+    id INT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('course', 'lesson')),
+    entity_id INT NOT NULL,
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    snapshot JSONB NOT NULL,
+    author_id INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (entity_type, entity_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS revision_course_idx ON revision(course_id);
+
+-- Revisions are history: they may only go away together with their course.
+CREATE OR REPLACE FUNCTION revision_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER revision_no_update BEFORE UPDATE ON revision
+    FOR EACH ROW EXECUTE FUNCTION revision_immutable();
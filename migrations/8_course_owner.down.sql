@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS course_owner_idx;
+ALTER TABLE course DROP COLUMN IF EXISTS owner_id;
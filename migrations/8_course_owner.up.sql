@@ -0,0 +1,5 @@
+-- Courses created before authentication existed have no owner and can
+-- only be edited by admins.
+ALTER TABLE course ADD COLUMN IF NOT EXISTS owner_id INT;
+
+CREATE INDEX IF NOT EXISTS course_owner_idx ON course(owner_id);
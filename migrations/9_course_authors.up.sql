@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS course_authors(
+    course_id INT NOT NULL REFERENCES course(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'editor', 'reviewer')),
+    added_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (course_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS course_authors_user_idx ON course_authors(user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS course_authors_one_owner_idx ON course_authors(course_id) WHERE role = 'owner';
+
+INSERT INTO course_authors(course_id, user_id, role)
+SELECT id, owner_id, 'owner' FROM course WHERE owner_id IS NOT NULL
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,21 @@
+// Package cache provides byte caches with a time to live, backed by an
+// in-memory LRU or by Redis.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Store is a key-value cache. Its operations map one to one onto Redis
+// commands, so any Redis compatible server can back it.
+type Store interface {
+	// Get returns the value of key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes keys. Missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+	// DeletePrefix removes every key that starts with prefix.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
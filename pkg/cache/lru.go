@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+// LRU is an in-memory Store that evicts the least recently used keys once
+// it holds size of them. Expired keys are dropped when they are read.
+type LRU struct {
+	cache *lru.Cache[string, entry]
+	now   func() time.Time
+}
+
+func NewLRU(size int) (*LRU, error) {
+	c, err := lru.New[string, entry](size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LRU{cache: c, now: time.Now}, nil
+}
+
+func (l *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {
+	e, ok := l.cache.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if !l.now().Before(e.expires) {
+		l.cache.Remove(key)
+		return nil, false, nil
+	}
+
+	return e.value, true, nil
+}
+
+func (l *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	l.cache.Add(key, entry{value: value, expires: l.now().Add(ttl)})
+	return nil
+}
+
+func (l *LRU) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		l.cache.Remove(key)
+	}
+	return nil
+}
+
+func (l *LRU) DeletePrefix(_ context.Context, prefix string) error {
+	for _, key := range l.cache.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			l.cache.Remove(key)
+		}
+	}
+	return nil
+}
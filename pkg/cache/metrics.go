@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics counts the requests, invalidations and errors of a Store.
+type Metrics struct {
+	requests      *prometheus.CounterVec
+	invalidations *prometheus.CounterVec
+	errors        *prometheus.CounterVec
+}
+
+// NewMetrics registers the cache counters with reg. Every counter is
+// labelled with the name of the cache.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cache_requests_total",
+			Help: "Cache lookups by result, hit or miss.",
+		}, []string{"cache", "result"}),
+		invalidations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cache_invalidations_total",
+			Help: "Deletions of cached keys or key prefixes.",
+		}, []string{"cache"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cache_errors_total",
+			Help: "Failed cache operations by operation.",
+		}, []string{"cache", "op"}),
+	}
+	reg.MustRegister(m.requests, m.invalidations, m.errors)
+
+	return m
+}
+
+// Instrument returns a Store that records its use in m under name.
+func (m *Metrics) Instrument(s Store, name string) Store {
+	return &instrumented{Store: s, name: name, m: m}
+}
+
+type instrumented struct {
+	Store
+	name string
+	m    *Metrics
+}
+
+func (s *instrumented) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, ok, err := s.Store.Get(ctx, key)
+	switch {
+	case err != nil:
+		s.m.errors.WithLabelValues(s.name, "get").Inc()
+	case ok:
+		s.m.requests.WithLabelValues(s.name, "hit").Inc()
+	default:
+		s.m.requests.WithLabelValues(s.name, "miss").Inc()
+	}
+	return value, ok, err
+}
+
+func (s *instrumented) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	err := s.Store.Set(ctx, key, value, ttl)
+	if err != nil {
+		s.m.errors.WithLabelValues(s.name, "set").Inc()
+	}
+	return err
+}
+
+func (s *instrumented) Delete(ctx context.Context, keys ...string) error {
+	s.m.invalidations.WithLabelValues(s.name).Inc()
+	err := s.Store.Delete(ctx, keys...)
+	if err != nil {
+		s.m.errors.WithLabelValues(s.name, "delete").Inc()
+	}
+	return err
+}
+
+func (s *instrumented) DeletePrefix(ctx context.Context, prefix string) error {
+	s.m.invalidations.WithLabelValues(s.name).Inc()
+	err := s.Store.DeletePrefix(ctx, prefix)
+	if err != nil {
+		s.m.errors.WithLabelValues(s.name, "delete").Inc()
+	}
+	return err
+}
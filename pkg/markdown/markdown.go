@@ -0,0 +1,194 @@
+// Package markdown renders lesson markdown to sanitized HTML and plain text.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Heading is an entry of the table of contents. ID is the anchor of the
+// heading in the rendered HTML.
+type Heading struct {
+	Level int
+	Text  string
+	ID    string
+}
+
+type Document struct {
+	HTML string
+	TOC  []Heading
+}
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+
+	policy = newPolicy()
+)
+
+// newPolicy allows what user generated content usually needs, plus the
+// heading anchors the table of contents links to and the language classes
+// of code blocks.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	return p
+}
+
+// Render converts src to HTML, sanitizes it and extracts the table of
+// contents. Raw HTML in src never reaches the output.
+func Render(src string) (*Document, error) {
+	source := []byte(src)
+	ctx := parser.NewContext(parser.WithIDs(&headingIDs{used: make(map[string]bool)}))
+	root := md.Parser().Parse(text.NewReader(source), parser.WithContext(ctx))
+
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, root); err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		HTML: policy.Sanitize(buf.String()),
+		TOC:  headings(root, source),
+	}, nil
+}
+
+// PlainText returns the text of src without any markup, one block per line.
+func PlainText(src string) string {
+	source := []byte(src)
+	root := md.Parser().Parse(text.NewReader(source))
+
+	var blocks []string
+	_ = ast.Walk(root, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Heading, *ast.Paragraph, *ast.TextBlock:
+			blocks = append(blocks, inlineText(n, source))
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			var sb strings.Builder
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				sb.Write(line.Value(source))
+			}
+			blocks = append(blocks, strings.TrimRight(sb.String(), "\n"))
+		case *east.TableHeader, *east.TableRow:
+			cells := make([]string, 0, n.ChildCount())
+			for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+				cells = append(cells, inlineText(c, source))
+			}
+			blocks = append(blocks, strings.Join(cells, "\t"))
+		case *ast.HTMLBlock:
+		default:
+			return ast.WalkContinue, nil
+		}
+		return ast.WalkSkipChildren, nil
+	})
+
+	return strings.Join(blocks, "\n")
+}
+
+func headings(root ast.Node, source []byte) []Heading {
+	var res []Heading
+	_ = ast.Walk(root, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		heading := Heading{Level: h.Level, Text: inlineText(h, source)}
+		if id, ok := h.AttributeString("id"); ok {
+			if b, ok := id.([]byte); ok {
+				heading.ID = string(b)
+			}
+		}
+		res = append(res, heading)
+
+		return ast.WalkSkipChildren, nil
+	})
+	return res
+}
+
+// inlineText concatenates the text of the inline children of n. Raw HTML
+// is dropped and line breaks become spaces.
+func inlineText(n ast.Node, source []byte) string {
+	var sb strings.Builder
+	var walk func(ast.Node)
+	walk = func(n ast.Node) {
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			switch c := c.(type) {
+			case *ast.Text:
+				sb.Write(c.Value(source))
+				if c.SoftLineBreak() || c.HardLineBreak() {
+					sb.WriteByte(' ')
+				}
+			case *ast.String:
+				sb.Write(c.Value)
+			case *ast.AutoLink:
+				sb.Write(c.URL(source))
+			case *ast.RawHTML:
+			default:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+
+	return strings.TrimSpace(sb.String())
+}
+
+// headingIDs generates heading anchors. Unlike the goldmark default it keeps
+// non-latin letters, so cyrillic headings get readable anchors too.
+type headingIDs struct {
+	used map[string]bool
+}
+
+func (ids *headingIDs) Generate(value []byte, _ ast.NodeKind) []byte {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			dash = false
+			sb.WriteRune(r)
+		default:
+			dash = true
+		}
+	}
+
+	id := sb.String()
+	if id == "" {
+		id = "heading"
+	}
+	base := id
+	for i := 1; ids.used[id]; i++ {
+		id = base + "-" + strconv.Itoa(i)
+	}
+	ids.used[id] = true
+
+	return []byte(id)
+}
+
+func (ids *headingIDs) Put(value []byte) {
+	ids.used[string(value)] = true
+}
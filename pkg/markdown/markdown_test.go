@@ -0,0 +1,77 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderStripsUnsafeMarkup(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		bad  []string
+	}{
+		{name: "script block", src: "<script>alert(1)</script>\n\ntext", bad: []string{"<script", "alert(1)"}},
+		{name: "inline script", src: "text <script>alert(1)</script> text", bad: []string{"<script"}},
+		{name: "javascript link", src: "[click](javascript:alert(1))", bad: []string{"javascript:"}},
+		{name: "javascript image", src: "![img](javascript:alert(1))", bad: []string{"javascript:"}},
+		{name: "javascript html link", src: `<a href="javascript:alert(1)">click</a>`, bad: []string{"javascript:"}},
+		{name: "onerror", src: `<img src="x" onerror="alert(1)">`, bad: []string{"onerror"}},
+		{name: "onerror inline", src: `text <img src=x onerror=alert(1)> text`, bad: []string{"onerror"}},
+		{name: "iframe", src: `<iframe src="https://example.com"></iframe>`, bad: []string{"<iframe"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Render(tt.src)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, bad := range tt.bad {
+				if strings.Contains(strings.ToLower(doc.HTML), bad) {
+					t.Errorf("Render() = %q, contains %q", doc.HTML, bad)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderKeepsSafeMarkup(t *testing.T) {
+	doc, err := Render("[site](https://example.com)\n\n```go\nfmt.Println()\n```")
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	for _, want := range []string{`href="https://example.com"`, `class="language-go"`} {
+		if !strings.Contains(doc.HTML, want) {
+			t.Errorf("Render() = %q, want it to contain %q", doc.HTML, want)
+		}
+	}
+}
+
+func TestRenderHeadingIDs(t *testing.T) {
+	doc, err := Render("# Intro\n\n## Intro\n\n## Intro 1\n\n# Введение в Go\n\n# !!!")
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := []string{"intro", "intro-1", "intro-1-1", "введение-в-go", "heading"}
+	if len(doc.TOC) != len(want) {
+		t.Fatalf("Render() toc = %v, want %d headings", doc.TOC, len(want))
+	}
+
+	seen := make(map[string]bool)
+	for i, h := range doc.TOC {
+		if h.ID != want[i] {
+			t.Errorf("heading %d id = %q, want %q", i, h.ID, want[i])
+		}
+		if seen[h.ID] {
+			t.Errorf("heading %d id %q is not unique", i, h.ID)
+		}
+		seen[h.ID] = true
+
+		if !strings.Contains(doc.HTML, `id="`+h.ID+`"`) {
+			t.Errorf("Render() = %q, lost the id %q", doc.HTML, h.ID)
+		}
+	}
+}
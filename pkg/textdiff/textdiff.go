@@ -0,0 +1,151 @@
+// Package textdiff produces line based diffs of two texts.
+package textdiff
+
+import "strings"
+
+type OpKind int
+
+const (
+	Equal OpKind = iota
+	Insert
+	Delete
+)
+
+type Op struct {
+	Kind OpKind
+	Line string
+}
+
+// maxCells caps the size of the LCS table. Texts whose changed middle
+// would need a bigger one are diffed as a whole replacement instead, which
+// is still a correct, if not the shortest, edit script.
+const maxCells = 4 << 20
+
+// Lines returns an edit script turning a into b, one line per op. Common
+// leading and trailing lines are matched first, the changed middle uses
+// the classic LCS table, which is fine for lesson sized texts.
+func Lines(a, b string) []Op {
+	x := splitLines(a)
+	y := splitLines(b)
+
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix &&
+		x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(x)+len(y)-prefix-suffix)
+	for _, l := range x[:prefix] {
+		ops = append(ops, Op{Equal, l})
+	}
+	ops = appendMiddle(ops, x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])
+	for _, l := range x[len(x)-suffix:] {
+		ops = append(ops, Op{Equal, l})
+	}
+
+	return ops
+}
+
+func appendMiddle(ops []Op, x, y []string) []Op {
+	if len(x)*len(y) > maxCells {
+		for _, l := range x {
+			ops = append(ops, Op{Delete, l})
+		}
+		for _, l := range y {
+			ops = append(ops, Op{Insert, l})
+		}
+		return ops
+	}
+
+	lcs := make([][]int32, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			ops = append(ops, Op{Equal, x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, Op{Delete, x[i]})
+			i++
+		default:
+			ops = append(ops, Op{Insert, y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		ops = append(ops, Op{Delete, x[i]})
+	}
+	for ; j < len(y); j++ {
+		ops = append(ops, Op{Insert, y[j]})
+	}
+
+	return ops
+}
+
+// Unified renders the diff of a and b with "-", "+" and " " line prefixes.
+// Unchanged lines further than context lines away from a change are left
+// out and replaced by a "@@" separator.
+func Unified(a, b string, context int) string {
+	ops := Lines(a, b)
+
+	keep := make([]bool, len(ops))
+	for i, op := range ops {
+		if op.Kind == Equal {
+			continue
+		}
+		for k := max(0, i-context); k <= min(len(ops)-1, i+context); k++ {
+			keep[k] = true
+		}
+	}
+
+	var sb strings.Builder
+	skipped := false
+	for i, op := range ops {
+		if !keep[i] {
+			skipped = true
+			continue
+		}
+		if skipped && sb.Len() > 0 {
+			sb.WriteString("@@\n")
+		}
+		skipped = false
+
+		switch op.Kind {
+		case Equal:
+			sb.WriteString(" ")
+		case Insert:
+			sb.WriteString("+")
+		case Delete:
+			sb.WriteString("-")
+		}
+		sb.WriteString(op.Line)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
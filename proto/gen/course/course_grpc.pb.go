@@ -20,11 +20,53 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CourseService_GetAll_FullMethodName = "/CourseService/GetAll"
-	CourseService_Get_FullMethodName    = "/CourseService/Get"
-	CourseService_Create_FullMethodName = "/CourseService/Create"
-	CourseService_Delete_FullMethodName = "/CourseService/Delete"
-	CourseService_Update_FullMethodName = "/CourseService/Update"
+	CourseService_GetAll_FullMethodName              = "/CourseService/GetAll"
+	CourseService_ListCourses_FullMethodName         = "/CourseService/ListCourses"
+	CourseService_SearchCourses_FullMethodName       = "/CourseService/SearchCourses"
+	CourseService_Get_FullMethodName                 = "/CourseService/Get"
+	CourseService_StreamCourses_FullMethodName       = "/CourseService/StreamCourses"
+	CourseService_StreamCourseContent_FullMethodName = "/CourseService/StreamCourseContent"
+	CourseService_Create_FullMethodName              = "/CourseService/Create"
+	CourseService_Delete_FullMethodName              = "/CourseService/Delete"
+	CourseService_RestoreCourse_FullMethodName       = "/CourseService/RestoreCourse"
+	CourseService_ListDeletedCourses_FullMethodName  = "/CourseService/ListDeletedCourses"
+	CourseService_Update_FullMethodName              = "/CourseService/Update"
+	CourseService_Reorder_FullMethodName             = "/CourseService/Reorder"
+	CourseService_ExportCourse_FullMethodName        = "/CourseService/ExportCourse"
+	CourseService_ImportCourse_FullMethodName        = "/CourseService/ImportCourse"
+	CourseService_ImportPackage_FullMethodName       = "/CourseService/ImportPackage"
+	CourseService_SubmitForReview_FullMethodName     = "/CourseService/SubmitForReview"
+	CourseService_Publish_FullMethodName             = "/CourseService/Publish"
+	CourseService_Unpublish_FullMethodName           = "/CourseService/Unpublish"
+	CourseService_Archive_FullMethodName             = "/CourseService/Archive"
+	CourseService_CreateTheme_FullMethodName         = "/CourseService/CreateTheme"
+	CourseService_GetTheme_FullMethodName            = "/CourseService/GetTheme"
+	CourseService_UpdateTheme_FullMethodName         = "/CourseService/UpdateTheme"
+	CourseService_DeleteTheme_FullMethodName         = "/CourseService/DeleteTheme"
+	CourseService_CreateLesson_FullMethodName        = "/CourseService/CreateLesson"
+	CourseService_GetLesson_FullMethodName           = "/CourseService/GetLesson"
+	CourseService_UpdateLesson_FullMethodName        = "/CourseService/UpdateLesson"
+	CourseService_DeleteLesson_FullMethodName        = "/CourseService/DeleteLesson"
+	CourseService_ListRevisions_FullMethodName       = "/CourseService/ListRevisions"
+	CourseService_GetRevision_FullMethodName         = "/CourseService/GetRevision"
+	CourseService_DiffRevisions_FullMethodName       = "/CourseService/DiffRevisions"
+	CourseService_RevertToRevision_FullMethodName    = "/CourseService/RevertToRevision"
+	CourseService_AddCoAuthor_FullMethodName         = "/CourseService/AddCoAuthor"
+	CourseService_RemoveCoAuthor_FullMethodName      = "/CourseService/RemoveCoAuthor"
+	CourseService_ListCoAuthors_FullMethodName       = "/CourseService/ListCoAuthors"
+	CourseService_ListMyCourses_FullMethodName       = "/CourseService/ListMyCourses"
+	CourseService_Enroll_FullMethodName              = "/CourseService/Enroll"
+	CourseService_Unenroll_FullMethodName            = "/CourseService/Unenroll"
+	CourseService_ListEnrollments_FullMethodName     = "/CourseService/ListEnrollments"
+	CourseService_ListCourseStudents_FullMethodName  = "/CourseService/ListCourseStudents"
+	CourseService_MarkLessonStarted_FullMethodName   = "/CourseService/MarkLessonStarted"
+	CourseService_MarkLessonCompleted_FullMethodName = "/CourseService/MarkLessonCompleted"
+	CourseService_GetCourseProgress_FullMethodName   = "/CourseService/GetCourseProgress"
+	CourseService_SetLessonTask_FullMethodName       = "/CourseService/SetLessonTask"
+	CourseService_GetLessonTask_FullMethodName       = "/CourseService/GetLessonTask"
+	CourseService_SubmitTaskAnswer_FullMethodName    = "/CourseService/SubmitTaskAnswer"
+	CourseService_ListAttempts_FullMethodName        = "/CourseService/ListAttempts"
+	CourseService_ReviewAttempt_FullMethodName       = "/CourseService/ReviewAttempt"
 )
 
 // CourseServiceClient is the client API for CourseService service.
@@ -32,10 +74,58 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CourseServiceClient interface {
 	GetAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponse, error)
+	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
+	SearchCourses(ctx context.Context, in *SearchCoursesRequest, opts ...grpc.CallOption) (*SearchCoursesResponse, error)
 	Get(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*GetCourseResponse, error)
-	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// The streaming counterparts of GetAll and Get for catalogs and courses
+	// too large for a single message.
+	StreamCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Course], error)
+	StreamCourseContent(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CourseContent], error)
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	Delete(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	ListDeletedCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponse, error)
 	Update(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	ExportCourse(ctx context.Context, in *ExportCourseRequest, opts ...grpc.CallOption) (*CourseArchive, error)
+	ImportCourse(ctx context.Context, in *CourseArchive, opts ...grpc.CallOption) (*ImportCourseResponse, error)
+	// Creates a course from a zipped IMS Common Cartridge or SCORM 1.2
+	// package. Small packages fit into a single message, larger ones are
+	// split over several.
+	ImportPackage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPackageRequest, ImportPackageResponse], error)
+	SubmitForReview(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error)
+	Publish(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error)
+	Unpublish(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error)
+	Archive(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error)
+	CreateTheme(ctx context.Context, in *CreateThemeRequest, opts ...grpc.CallOption) (*CreatedTheme, error)
+	GetTheme(ctx context.Context, in *GetThemeRequest, opts ...grpc.CallOption) (*Theme, error)
+	UpdateTheme(ctx context.Context, in *UpdateThemeRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	DeleteTheme(ctx context.Context, in *DeleteThemeRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error)
+	GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
+	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*Revision, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*Revision, error)
+	AddCoAuthor(ctx context.Context, in *AddCoAuthorRequest, opts ...grpc.CallOption) (*CoAuthor, error)
+	RemoveCoAuthor(ctx context.Context, in *RemoveCoAuthorRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	ListCoAuthors(ctx context.Context, in *ListCoAuthorsRequest, opts ...grpc.CallOption) (*ListCoAuthorsResponse, error)
+	ListMyCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponse, error)
+	Enroll(ctx context.Context, in *EnrollRequest, opts ...grpc.CallOption) (*Enrollment, error)
+	Unenroll(ctx context.Context, in *UnenrollRequest, opts ...grpc.CallOption) (*Enrollment, error)
+	ListEnrollments(ctx context.Context, in *ListEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error)
+	ListCourseStudents(ctx context.Context, in *ListCourseStudentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error)
+	MarkLessonStarted(ctx context.Context, in *MarkLessonRequest, opts ...grpc.CallOption) (*LessonProgress, error)
+	MarkLessonCompleted(ctx context.Context, in *MarkLessonRequest, opts ...grpc.CallOption) (*CourseProgress, error)
+	GetCourseProgress(ctx context.Context, in *GetCourseProgressRequest, opts ...grpc.CallOption) (*CourseProgress, error)
+	SetLessonTask(ctx context.Context, in *TaskSpec, opts ...grpc.CallOption) (*TaskSpec, error)
+	GetLessonTask(ctx context.Context, in *GetLessonTaskRequest, opts ...grpc.CallOption) (*TaskSpec, error)
+	SubmitTaskAnswer(ctx context.Context, in *SubmitTaskAnswerRequest, opts ...grpc.CallOption) (*Attempt, error)
+	ListAttempts(ctx context.Context, in *ListAttemptsRequest, opts ...grpc.CallOption) (*ListAttemptsResponse, error)
+	// Scores an attempt that is pending review, such as every code answer.
+	ReviewAttempt(ctx context.Context, in *ReviewAttemptRequest, opts ...grpc.CallOption) (*Attempt, error)
 }
 
 type courseServiceClient struct {
@@ -56,6 +146,26 @@ func (c *courseServiceClient) GetAll(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *courseServiceClient) ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SearchCourses(ctx context.Context, in *SearchCoursesRequest, opts ...grpc.CallOption) (*SearchCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCoursesResponse)
+	err := c.cc.Invoke(ctx, CourseService_SearchCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courseServiceClient) Get(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*GetCourseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCourseResponse)
@@ -63,180 +173,1506 @@ func (c *courseServiceClient) Get(ctx context.Context, in *GetCourseRequest, opt
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *courseServiceClient) StreamCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Course], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[0], CourseService_StreamCourses_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Course]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_StreamCoursesClient = grpc.ServerStreamingClient[Course]
+
+func (c *courseServiceClient) StreamCourseContent(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CourseContent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[1], CourseService_StreamCourseContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCourseRequest, CourseContent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_StreamCourseContentClient = grpc.ServerStreamingClient[CourseContent]
+
+func (c *courseServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, CourseService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Delete(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RestoreCourse(ctx context.Context, in *RestoreCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_RestoreCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListDeletedCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListDeletedCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Update(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_Reorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ExportCourse(ctx context.Context, in *ExportCourseRequest, opts ...grpc.CallOption) (*CourseArchive, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseArchive)
+	err := c.cc.Invoke(ctx, CourseService_ExportCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ImportCourse(ctx context.Context, in *CourseArchive, opts ...grpc.CallOption) (*ImportCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_ImportCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ImportPackage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPackageRequest, ImportPackageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[2], CourseService_ImportPackage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPackageRequest, ImportPackageResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ImportPackageClient = grpc.ClientStreamingClient[ImportPackageRequest, ImportPackageResponse]
+
+func (c *courseServiceClient) SubmitForReview(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCourseStatusResponse)
+	err := c.cc.Invoke(ctx, CourseService_SubmitForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Publish(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCourseStatusResponse)
+	err := c.cc.Invoke(ctx, CourseService_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Unpublish(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCourseStatusResponse)
+	err := c.cc.Invoke(ctx, CourseService_Unpublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Archive(ctx context.Context, in *ChangeCourseStatusRequest, opts ...grpc.CallOption) (*ChangeCourseStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCourseStatusResponse)
+	err := c.cc.Invoke(ctx, CourseService_Archive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateTheme(ctx context.Context, in *CreateThemeRequest, opts ...grpc.CallOption) (*CreatedTheme, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatedTheme)
+	err := c.cc.Invoke(ctx, CourseService_CreateTheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetTheme(ctx context.Context, in *GetThemeRequest, opts ...grpc.CallOption) (*Theme, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Theme)
+	err := c.cc.Invoke(ctx, CourseService_GetTheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateTheme(ctx context.Context, in *UpdateThemeRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateTheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteTheme(ctx context.Context, in *DeleteThemeRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_DeleteTheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLessonResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*Lesson, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lesson)
+	err := c.cc.Invoke(ctx, CourseService_GetLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_DeleteLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, CourseService_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, CourseService_RevertToRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) AddCoAuthor(ctx context.Context, in *AddCoAuthorRequest, opts ...grpc.CallOption) (*CoAuthor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoAuthor)
+	err := c.cc.Invoke(ctx, CourseService_AddCoAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RemoveCoAuthor(ctx context.Context, in *RemoveCoAuthorRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_RemoveCoAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCoAuthors(ctx context.Context, in *ListCoAuthorsRequest, opts ...grpc.CallOption) (*ListCoAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoAuthorsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCoAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListMyCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListMyCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Enroll(ctx context.Context, in *EnrollRequest, opts ...grpc.CallOption) (*Enrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Enrollment)
+	err := c.cc.Invoke(ctx, CourseService_Enroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) Unenroll(ctx context.Context, in *UnenrollRequest, opts ...grpc.CallOption) (*Enrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Enrollment)
+	err := c.cc.Invoke(ctx, CourseService_Unenroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListEnrollments(ctx context.Context, in *ListEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListEnrollments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCourseStudents(ctx context.Context, in *ListCourseStudentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourseStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) MarkLessonStarted(ctx context.Context, in *MarkLessonRequest, opts ...grpc.CallOption) (*LessonProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonProgress)
+	err := c.cc.Invoke(ctx, CourseService_MarkLessonStarted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) MarkLessonCompleted(ctx context.Context, in *MarkLessonRequest, opts ...grpc.CallOption) (*CourseProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseProgress)
+	err := c.cc.Invoke(ctx, CourseService_MarkLessonCompleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourseProgress(ctx context.Context, in *GetCourseProgressRequest, opts ...grpc.CallOption) (*CourseProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseProgress)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetLessonTask(ctx context.Context, in *TaskSpec, opts ...grpc.CallOption) (*TaskSpec, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskSpec)
+	err := c.cc.Invoke(ctx, CourseService_SetLessonTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetLessonTask(ctx context.Context, in *GetLessonTaskRequest, opts ...grpc.CallOption) (*TaskSpec, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskSpec)
+	err := c.cc.Invoke(ctx, CourseService_GetLessonTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SubmitTaskAnswer(ctx context.Context, in *SubmitTaskAnswerRequest, opts ...grpc.CallOption) (*Attempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attempt)
+	err := c.cc.Invoke(ctx, CourseService_SubmitTaskAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListAttempts(ctx context.Context, in *ListAttemptsRequest, opts ...grpc.CallOption) (*ListAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttemptsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ReviewAttempt(ctx context.Context, in *ReviewAttemptRequest, opts ...grpc.CallOption) (*Attempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attempt)
+	err := c.cc.Invoke(ctx, CourseService_ReviewAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourseServiceServer is the server API for CourseService service.
+// All implementations must embed UnimplementedCourseServiceServer
+// for forward compatibility.
+type CourseServiceServer interface {
+	GetAll(context.Context, *emptypb.Empty) (*GetResponse, error)
+	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
+	SearchCourses(context.Context, *SearchCoursesRequest) (*SearchCoursesResponse, error)
+	Get(context.Context, *GetCourseRequest) (*GetCourseResponse, error)
+	// The streaming counterparts of GetAll and Get for catalogs and courses
+	// too large for a single message.
+	StreamCourses(*emptypb.Empty, grpc.ServerStreamingServer[Course]) error
+	StreamCourseContent(*GetCourseRequest, grpc.ServerStreamingServer[CourseContent]) error
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	Delete(context.Context, *DeleteCourseRequest) (*SuccessResponse, error)
+	RestoreCourse(context.Context, *RestoreCourseRequest) (*SuccessResponse, error)
+	ListDeletedCourses(context.Context, *emptypb.Empty) (*GetResponse, error)
+	Update(context.Context, *UpdateCourseRequest) (*SuccessResponse, error)
+	Reorder(context.Context, *ReorderRequest) (*SuccessResponse, error)
+	ExportCourse(context.Context, *ExportCourseRequest) (*CourseArchive, error)
+	ImportCourse(context.Context, *CourseArchive) (*ImportCourseResponse, error)
+	// Creates a course from a zipped IMS Common Cartridge or SCORM 1.2
+	// package. Small packages fit into a single message, larger ones are
+	// split over several.
+	ImportPackage(grpc.ClientStreamingServer[ImportPackageRequest, ImportPackageResponse]) error
+	SubmitForReview(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error)
+	Publish(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error)
+	Unpublish(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error)
+	Archive(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error)
+	CreateTheme(context.Context, *CreateThemeRequest) (*CreatedTheme, error)
+	GetTheme(context.Context, *GetThemeRequest) (*Theme, error)
+	UpdateTheme(context.Context, *UpdateThemeRequest) (*SuccessResponse, error)
+	DeleteTheme(context.Context, *DeleteThemeRequest) (*SuccessResponse, error)
+	CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error)
+	GetLesson(context.Context, *GetLessonRequest) (*Lesson, error)
+	UpdateLesson(context.Context, *UpdateLessonRequest) (*SuccessResponse, error)
+	DeleteLesson(context.Context, *DeleteLessonRequest) (*SuccessResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*Revision, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	RevertToRevision(context.Context, *RevertToRevisionRequest) (*Revision, error)
+	AddCoAuthor(context.Context, *AddCoAuthorRequest) (*CoAuthor, error)
+	RemoveCoAuthor(context.Context, *RemoveCoAuthorRequest) (*SuccessResponse, error)
+	ListCoAuthors(context.Context, *ListCoAuthorsRequest) (*ListCoAuthorsResponse, error)
+	ListMyCourses(context.Context, *emptypb.Empty) (*GetResponse, error)
+	Enroll(context.Context, *EnrollRequest) (*Enrollment, error)
+	Unenroll(context.Context, *UnenrollRequest) (*Enrollment, error)
+	ListEnrollments(context.Context, *ListEnrollmentsRequest) (*ListEnrollmentsResponse, error)
+	ListCourseStudents(context.Context, *ListCourseStudentsRequest) (*ListEnrollmentsResponse, error)
+	MarkLessonStarted(context.Context, *MarkLessonRequest) (*LessonProgress, error)
+	MarkLessonCompleted(context.Context, *MarkLessonRequest) (*CourseProgress, error)
+	GetCourseProgress(context.Context, *GetCourseProgressRequest) (*CourseProgress, error)
+	SetLessonTask(context.Context, *TaskSpec) (*TaskSpec, error)
+	GetLessonTask(context.Context, *GetLessonTaskRequest) (*TaskSpec, error)
+	SubmitTaskAnswer(context.Context, *SubmitTaskAnswerRequest) (*Attempt, error)
+	ListAttempts(context.Context, *ListAttemptsRequest) (*ListAttemptsResponse, error)
+	// Scores an attempt that is pending review, such as every code answer.
+	ReviewAttempt(context.Context, *ReviewAttemptRequest) (*Attempt, error)
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+// UnimplementedCourseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourseServiceServer struct{}
+
+func (UnimplementedCourseServiceServer) GetAll(context.Context, *emptypb.Empty) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) SearchCourses(context.Context, *SearchCoursesRequest) (*SearchCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) Get(context.Context, *GetCourseRequest) (*GetCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCourseServiceServer) StreamCourses(*emptypb.Empty, grpc.ServerStreamingServer[Course]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) StreamCourseContent(*GetCourseRequest, grpc.ServerStreamingServer[CourseContent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCourseContent not implemented")
+}
+func (UnimplementedCourseServiceServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedCourseServiceServer) Delete(context.Context, *DeleteCourseRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCourseServiceServer) RestoreCourse(context.Context, *RestoreCourseRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ListDeletedCourses(context.Context, *emptypb.Empty) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) Update(context.Context, *UpdateCourseRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedCourseServiceServer) Reorder(context.Context, *ReorderRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reorder not implemented")
+}
+func (UnimplementedCourseServiceServer) ExportCourse(context.Context, *ExportCourseRequest) (*CourseArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ImportCourse(context.Context, *CourseArchive) (*ImportCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ImportPackage(grpc.ClientStreamingServer[ImportPackageRequest, ImportPackageResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportPackage not implemented")
+}
+func (UnimplementedCourseServiceServer) SubmitForReview(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForReview not implemented")
+}
+func (UnimplementedCourseServiceServer) Publish(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedCourseServiceServer) Unpublish(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpublish not implemented")
+}
+func (UnimplementedCourseServiceServer) Archive(context.Context, *ChangeCourseStatusRequest) (*ChangeCourseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Archive not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateTheme(context.Context, *CreateThemeRequest) (*CreatedTheme, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTheme not implemented")
+}
+func (UnimplementedCourseServiceServer) GetTheme(context.Context, *GetThemeRequest) (*Theme, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTheme not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateTheme(context.Context, *UpdateThemeRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTheme not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteTheme(context.Context, *DeleteThemeRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTheme not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) GetLesson(context.Context, *GetLessonRequest) (*Lesson, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedCourseServiceServer) GetRevision(context.Context, *GetRevisionRequest) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedCourseServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedCourseServiceServer) RevertToRevision(context.Context, *RevertToRevisionRequest) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToRevision not implemented")
+}
+func (UnimplementedCourseServiceServer) AddCoAuthor(context.Context, *AddCoAuthorRequest) (*CoAuthor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCoAuthor not implemented")
+}
+func (UnimplementedCourseServiceServer) RemoveCoAuthor(context.Context, *RemoveCoAuthorRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoAuthor not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCoAuthors(context.Context, *ListCoAuthorsRequest) (*ListCoAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoAuthors not implemented")
+}
+func (UnimplementedCourseServiceServer) ListMyCourses(context.Context, *emptypb.Empty) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) Enroll(context.Context, *EnrollRequest) (*Enrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enroll not implemented")
+}
+func (UnimplementedCourseServiceServer) Unenroll(context.Context, *UnenrollRequest) (*Enrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unenroll not implemented")
+}
+func (UnimplementedCourseServiceServer) ListEnrollments(context.Context, *ListEnrollmentsRequest) (*ListEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnrollments not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourseStudents(context.Context, *ListCourseStudentsRequest) (*ListEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseStudents not implemented")
+}
+func (UnimplementedCourseServiceServer) MarkLessonStarted(context.Context, *MarkLessonRequest) (*LessonProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkLessonStarted not implemented")
+}
+func (UnimplementedCourseServiceServer) MarkLessonCompleted(context.Context, *MarkLessonRequest) (*CourseProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkLessonCompleted not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseProgress(context.Context, *GetCourseProgressRequest) (*CourseProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseProgress not implemented")
+}
+func (UnimplementedCourseServiceServer) SetLessonTask(context.Context, *TaskSpec) (*TaskSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonTask not implemented")
+}
+func (UnimplementedCourseServiceServer) GetLessonTask(context.Context, *GetLessonTaskRequest) (*TaskSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonTask not implemented")
+}
+func (UnimplementedCourseServiceServer) SubmitTaskAnswer(context.Context, *SubmitTaskAnswerRequest) (*Attempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTaskAnswer not implemented")
+}
+func (UnimplementedCourseServiceServer) ListAttempts(context.Context, *ListAttemptsRequest) (*ListAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttempts not implemented")
+}
+func (UnimplementedCourseServiceServer) ReviewAttempt(context.Context, *ReviewAttemptRequest) (*Attempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewAttempt not implemented")
+}
+func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
+func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourseServiceServer will
+// result in compilation errors.
+type UnsafeCourseServiceServer interface {
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourseService_ServiceDesc, srv)
+}
+
+func _CourseService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetAll(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourses(ctx, req.(*ListCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SearchCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SearchCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SearchCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SearchCourses(ctx, req.(*SearchCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Get(ctx, req.(*GetCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_StreamCourses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CourseServiceServer).StreamCourses(m, &grpc.GenericServerStream[emptypb.Empty, Course]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_StreamCoursesServer = grpc.ServerStreamingServer[Course]
+
+func _CourseService_StreamCourseContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCourseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CourseServiceServer).StreamCourseContent(m, &grpc.GenericServerStream[GetCourseRequest, CourseContent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_StreamCourseContentServer = grpc.ServerStreamingServer[CourseContent]
+
+func _CourseService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Delete(ctx, req.(*DeleteCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RestoreCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RestoreCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RestoreCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RestoreCourse(ctx, req.(*RestoreCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListDeletedCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListDeletedCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListDeletedCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListDeletedCourses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Update(ctx, req.(*UpdateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Reorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Reorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Reorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Reorder(ctx, req.(*ReorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ExportCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ExportCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ExportCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ExportCourse(ctx, req.(*ExportCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ImportCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseArchive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ImportCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ImportCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ImportCourse(ctx, req.(*CourseArchive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ImportPackage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourseServiceServer).ImportPackage(&grpc.GenericServerStream[ImportPackageRequest, ImportPackageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ImportPackageServer = grpc.ClientStreamingServer[ImportPackageRequest, ImportPackageResponse]
+
+func _CourseService_SubmitForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCourseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SubmitForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SubmitForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SubmitForReview(ctx, req.(*ChangeCourseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCourseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Publish(ctx, req.(*ChangeCourseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Unpublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCourseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Unpublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Unpublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Unpublish(ctx, req.(*ChangeCourseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_Archive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCourseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Archive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Archive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Archive(ctx, req.(*ChangeCourseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateTheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateTheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateTheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateTheme(ctx, req.(*CreateThemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetTheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetTheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetTheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetTheme(ctx, req.(*GetThemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateTheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateThemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateTheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateTheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateTheme(ctx, req.(*UpdateThemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteTheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteThemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteTheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteTheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteTheme(ctx, req.(*DeleteThemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateLesson(ctx, req.(*CreateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetLesson(ctx, req.(*GetLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateLesson(ctx, req.(*UpdateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteLesson(ctx, req.(*DeleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RevertToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RevertToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RevertToRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RevertToRevision(ctx, req.(*RevertToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AddCoAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCoAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AddCoAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AddCoAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AddCoAuthor(ctx, req.(*AddCoAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RemoveCoAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCoAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RemoveCoAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RemoveCoAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RemoveCoAuthor(ctx, req.(*RemoveCoAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCoAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCoAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCoAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCoAuthors(ctx, req.(*ListCoAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *courseServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, CourseService_Create_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CourseService_ListMyCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListMyCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListMyCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListMyCourses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *courseServiceClient) Delete(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, CourseService_Delete_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CourseService_Enroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Enroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Enroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Enroll(ctx, req.(*EnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *courseServiceClient) Update(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
-	err := c.cc.Invoke(ctx, CourseService_Update_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CourseService_Unenroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnenrollRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CourseServiceServer).Unenroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_Unenroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).Unenroll(ctx, req.(*UnenrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// CourseServiceServer is the server API for CourseService service.
-// All implementations must embed UnimplementedCourseServiceServer
-// for forward compatibility.
-type CourseServiceServer interface {
-	GetAll(context.Context, *emptypb.Empty) (*GetResponse, error)
-	Get(context.Context, *GetCourseRequest) (*GetCourseResponse, error)
-	Create(context.Context, *CreateRequest) (*SuccessResponse, error)
-	Delete(context.Context, *DeleteCourseRequest) (*SuccessResponse, error)
-	Update(context.Context, *UpdateCourseRequest) (*SuccessResponse, error)
-	mustEmbedUnimplementedCourseServiceServer()
+func _CourseService_ListEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListEnrollments(ctx, req.(*ListEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedCourseServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCourseServiceServer struct{}
-
-func (UnimplementedCourseServiceServer) GetAll(context.Context, *emptypb.Empty) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
-}
-func (UnimplementedCourseServiceServer) Get(context.Context, *GetCourseRequest) (*GetCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (UnimplementedCourseServiceServer) Create(context.Context, *CreateRequest) (*SuccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (UnimplementedCourseServiceServer) Delete(context.Context, *DeleteCourseRequest) (*SuccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func _CourseService_ListCourseStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourseStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourseStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourseStudents(ctx, req.(*ListCourseStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCourseServiceServer) Update(context.Context, *UpdateCourseRequest) (*SuccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+
+func _CourseService_MarkLessonStarted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).MarkLessonStarted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_MarkLessonStarted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).MarkLessonStarted(ctx, req.(*MarkLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
-func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CourseServiceServer will
-// result in compilation errors.
-type UnsafeCourseServiceServer interface {
-	mustEmbedUnimplementedCourseServiceServer()
+func _CourseService_MarkLessonCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).MarkLessonCompleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_MarkLessonCompleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).MarkLessonCompleted(ctx, req.(*MarkLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CourseService_GetCourseProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CourseService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseProgress(ctx, req.(*GetCourseProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _CourseService_SetLessonTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskSpec)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).GetAll(ctx, in)
+		return srv.(CourseServiceServer).SetLessonTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_GetAll_FullMethodName,
+		FullMethod: CourseService_SetLessonTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetAll(ctx, req.(*emptypb.Empty))
+		return srv.(CourseServiceServer).SetLessonTask(ctx, req.(*TaskSpec))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseRequest)
+func _CourseService_GetLessonTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).Get(ctx, in)
+		return srv.(CourseServiceServer).GetLessonTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_Get_FullMethodName,
+		FullMethod: CourseService_GetLessonTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).Get(ctx, req.(*GetCourseRequest))
+		return srv.(CourseServiceServer).GetLessonTask(ctx, req.(*GetLessonTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
+func _CourseService_SubmitTaskAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTaskAnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).Create(ctx, in)
+		return srv.(CourseServiceServer).SubmitTaskAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_Create_FullMethodName,
+		FullMethod: CourseService_SubmitTaskAnswer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).Create(ctx, req.(*CreateRequest))
+		return srv.(CourseServiceServer).SubmitTaskAnswer(ctx, req.(*SubmitTaskAnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCourseRequest)
+func _CourseService_ListAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttemptsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).Delete(ctx, in)
+		return srv.(CourseServiceServer).ListAttempts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_Delete_FullMethodName,
+		FullMethod: CourseService_ListAttempts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).Delete(ctx, req.(*DeleteCourseRequest))
+		return srv.(CourseServiceServer).ListAttempts(ctx, req.(*ListAttemptsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCourseRequest)
+func _CourseService_ReviewAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAttemptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).Update(ctx, in)
+		return srv.(CourseServiceServer).ReviewAttempt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_Update_FullMethodName,
+		FullMethod: CourseService_ReviewAttempt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).Update(ctx, req.(*UpdateCourseRequest))
+		return srv.(CourseServiceServer).ReviewAttempt(ctx, req.(*ReviewAttemptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -252,6 +1688,14 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _CourseService_GetAll_Handler,
 		},
+		{
+			MethodName: "ListCourses",
+			Handler:    _CourseService_ListCourses_Handler,
+		},
+		{
+			MethodName: "SearchCourses",
+			Handler:    _CourseService_SearchCourses_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _CourseService_Get_Handler,
@@ -264,11 +1708,175 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _CourseService_Delete_Handler,
 		},
+		{
+			MethodName: "RestoreCourse",
+			Handler:    _CourseService_RestoreCourse_Handler,
+		},
+		{
+			MethodName: "ListDeletedCourses",
+			Handler:    _CourseService_ListDeletedCourses_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _CourseService_Update_Handler,
 		},
+		{
+			MethodName: "Reorder",
+			Handler:    _CourseService_Reorder_Handler,
+		},
+		{
+			MethodName: "ExportCourse",
+			Handler:    _CourseService_ExportCourse_Handler,
+		},
+		{
+			MethodName: "ImportCourse",
+			Handler:    _CourseService_ImportCourse_Handler,
+		},
+		{
+			MethodName: "SubmitForReview",
+			Handler:    _CourseService_SubmitForReview_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _CourseService_Publish_Handler,
+		},
+		{
+			MethodName: "Unpublish",
+			Handler:    _CourseService_Unpublish_Handler,
+		},
+		{
+			MethodName: "Archive",
+			Handler:    _CourseService_Archive_Handler,
+		},
+		{
+			MethodName: "CreateTheme",
+			Handler:    _CourseService_CreateTheme_Handler,
+		},
+		{
+			MethodName: "GetTheme",
+			Handler:    _CourseService_GetTheme_Handler,
+		},
+		{
+			MethodName: "UpdateTheme",
+			Handler:    _CourseService_UpdateTheme_Handler,
+		},
+		{
+			MethodName: "DeleteTheme",
+			Handler:    _CourseService_DeleteTheme_Handler,
+		},
+		{
+			MethodName: "CreateLesson",
+			Handler:    _CourseService_CreateLesson_Handler,
+		},
+		{
+			MethodName: "GetLesson",
+			Handler:    _CourseService_GetLesson_Handler,
+		},
+		{
+			MethodName: "UpdateLesson",
+			Handler:    _CourseService_UpdateLesson_Handler,
+		},
+		{
+			MethodName: "DeleteLesson",
+			Handler:    _CourseService_DeleteLesson_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _CourseService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _CourseService_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _CourseService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RevertToRevision",
+			Handler:    _CourseService_RevertToRevision_Handler,
+		},
+		{
+			MethodName: "AddCoAuthor",
+			Handler:    _CourseService_AddCoAuthor_Handler,
+		},
+		{
+			MethodName: "RemoveCoAuthor",
+			Handler:    _CourseService_RemoveCoAuthor_Handler,
+		},
+		{
+			MethodName: "ListCoAuthors",
+			Handler:    _CourseService_ListCoAuthors_Handler,
+		},
+		{
+			MethodName: "ListMyCourses",
+			Handler:    _CourseService_ListMyCourses_Handler,
+		},
+		{
+			MethodName: "Enroll",
+			Handler:    _CourseService_Enroll_Handler,
+		},
+		{
+			MethodName: "Unenroll",
+			Handler:    _CourseService_Unenroll_Handler,
+		},
+		{
+			MethodName: "ListEnrollments",
+			Handler:    _CourseService_ListEnrollments_Handler,
+		},
+		{
+			MethodName: "ListCourseStudents",
+			Handler:    _CourseService_ListCourseStudents_Handler,
+		},
+		{
+			MethodName: "MarkLessonStarted",
+			Handler:    _CourseService_MarkLessonStarted_Handler,
+		},
+		{
+			MethodName: "MarkLessonCompleted",
+			Handler:    _CourseService_MarkLessonCompleted_Handler,
+		},
+		{
+			MethodName: "GetCourseProgress",
+			Handler:    _CourseService_GetCourseProgress_Handler,
+		},
+		{
+			MethodName: "SetLessonTask",
+			Handler:    _CourseService_SetLessonTask_Handler,
+		},
+		{
+			MethodName: "GetLessonTask",
+			Handler:    _CourseService_GetLessonTask_Handler,
+		},
+		{
+			MethodName: "SubmitTaskAnswer",
+			Handler:    _CourseService_SubmitTaskAnswer_Handler,
+		},
+		{
+			MethodName: "ListAttempts",
+			Handler:    _CourseService_ListAttempts_Handler,
+		},
+		{
+			MethodName: "ReviewAttempt",
+			Handler:    _CourseService_ReviewAttempt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCourses",
+			Handler:       _CourseService_StreamCourses_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamCourseContent",
+			Handler:       _CourseService_StreamCourseContent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportPackage",
+			Handler:       _CourseService_ImportPackage_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "course/course.proto",
 }